@@ -0,0 +1,313 @@
+package decimal128
+
+import "math/big"
+
+// Accumulator sums a sequence of Decimal values, rounding only once when the
+// result is requested. Chaining calls to [Decimal.Add] rounds after every
+// addition, so the total of a long sequence of values with differing exponents
+// can drift away from the exact sum. An Accumulator instead holds the exact
+// sum, so its result is always correctly rounded. The sum is kept in a running
+// total with 57 significant digits while the digits of the values added fit
+// within it, and in an arbitrary precision integer once they don't, which is
+// slower and allocates.
+//
+// The zero value for Accumulator is an empty sum, ready to use. A single
+// Accumulator must not be used concurrently by multiple goroutines.
+type Accumulator struct {
+	hi accumulatorPart
+
+	// Once the sum no longer fits in hi, it is held exactly in sum, scaled by
+	// the exponent exp.
+	sum *big.Int
+	exp int16
+
+	// Track the signs of the values that have been added, which determine the
+	// sign of a zero result, and any special values.
+	anyPos bool
+	anyNeg bool
+	posInf bool
+	negInf bool
+	isNaN  bool
+	nan    Decimal
+}
+
+// Add adds d to the running total.
+func (a *Accumulator) Add(d Decimal) {
+	neg := d.Signbit()
+
+	if neg {
+		a.anyNeg = true
+	} else {
+		a.anyPos = true
+	}
+
+	if d.isSpecial() {
+		if d.IsNaN() {
+			if !a.isNaN {
+				a.nan = d.quiet()
+				a.isNaN = true
+			}
+		} else if neg {
+			a.negInf = true
+		} else {
+			a.posInf = true
+		}
+
+		return
+	}
+
+	sig, exp := d.decompose()
+
+	if sig[0]|sig[1] == 0 {
+		return
+	}
+
+	val := accumulatorPart{uint192{sig[0], sig[1], 0}, exp, neg}
+
+	if a.sum != nil {
+		a.addBig(val)
+		return
+	}
+
+	if a.hi.sig == (uint192{}) {
+		a.hi = val
+		return
+	}
+
+	res, rem, carry := a.hi.add(val)
+
+	if rem.sig == (uint192{}) && carry.sig == (uint192{}) {
+		a.hi = res
+		return
+	}
+
+	// The sum needs more digits than the running total holds, so move it to
+	// an arbitrary precision integer.
+	a.sum = new(big.Int)
+	a.exp = a.hi.exp
+	a.addBig(a.hi)
+	a.addBig(val)
+}
+
+// Reset clears the running total, making the Accumulator an empty sum.
+func (a *Accumulator) Reset() {
+	*a = Accumulator{}
+}
+
+// Result returns the sum of all the values that have been added, rounded
+// using the [DefaultRoundingMode], and reports whether the result is exactly
+// equal to the sum.
+func (a *Accumulator) Result() (Decimal, bool) {
+	return a.ResultWithMode(DefaultRoundingMode)
+}
+
+// ResultWithMode returns the sum of all the values that have been added,
+// rounded using the provided rounding mode, and reports whether the result is
+// exactly equal to the sum. An empty sum is 0.
+//
+// If any of the values added was NaN, or both +Inf and -Inf were added, the
+// result is NaN. Otherwise if an infinite value was added the result is that
+// infinity. Neither case is reported as exact.
+//
+// A zero sum is only negative if every value added was negative, or if the
+// rounding mode is ToNegativeInf and the values added were of mixed signs.
+func (a *Accumulator) ResultWithMode(mode RoundingMode) (Decimal, bool) {
+	if a.isNaN {
+		return a.nan, false
+	}
+
+	if a.posInf || a.negInf {
+		if a.posInf && a.negInf {
+			return nan(payloadOpAdd, payloadValPosInfinite, payloadValNegInfinite), false
+		}
+
+		return inf(a.negInf), false
+	}
+
+	if a.sum != nil {
+		return a.resultBig(mode)
+	}
+
+	tot := a.hi
+
+	if tot.sig == (uint192{}) {
+		return a.zero(mode), true
+	}
+
+	sig, exp := mode.reduce192(tot.neg, tot.sig, tot.exp, 0)
+
+	if exp > maxBiasedExponent {
+		return inf(tot.neg), false
+	}
+
+	res := compose(tot.neg, sig, exp)
+
+	// The total was held exactly, so the result is exact if scaling the
+	// rounded coefficient back to the exponent of the total reproduces it.
+	if exp >= tot.exp {
+		if exp-tot.exp >= int16(len(uint192PowersOf10)) {
+			return res, false
+		}
+
+		prd := uint192{sig[0], sig[1], 0}.mul(uint192PowersOf10[exp-tot.exp])
+		return res, prd == uint384{tot.sig[0], tot.sig[1], tot.sig[2], 0, 0, 0}
+	}
+
+	if tot.exp-exp >= int16(len(uint192PowersOf10)) {
+		return res, false
+	}
+
+	prd := tot.sig.mul(uint192PowersOf10[tot.exp-exp])
+	return res, prd == uint384{sig[0], sig[1], 0, 0, 0, 0}
+}
+
+func (a *Accumulator) addBig(val accumulatorPart) {
+	if val.sig == (uint192{}) {
+		return
+	}
+
+	ten := big.NewInt(10)
+
+	v := new(big.Int).SetUint64(val.sig[2])
+	v.Lsh(v, 64).Or(v, new(big.Int).SetUint64(val.sig[1]))
+	v.Lsh(v, 64).Or(v, new(big.Int).SetUint64(val.sig[0]))
+
+	if val.neg {
+		v.Neg(v)
+	}
+
+	if val.exp > a.exp {
+		v.Mul(v, new(big.Int).Exp(ten, big.NewInt(int64(val.exp-a.exp)), nil))
+	} else if val.exp < a.exp {
+		a.sum.Mul(a.sum, new(big.Int).Exp(ten, big.NewInt(int64(a.exp-val.exp)), nil))
+		a.exp = val.exp
+	}
+
+	a.sum.Add(a.sum, v)
+}
+
+func (a *Accumulator) resultBig(mode RoundingMode) (Decimal, bool) {
+	if a.sum.Sign() == 0 {
+		return a.zero(mode), true
+	}
+
+	neg := a.sum.Sign() < 0
+	sig, exp := mode.reduceBig(neg, a.sum, a.exp)
+
+	if exp > maxBiasedExponent {
+		return inf(neg), false
+	}
+
+	res := compose(neg, sig, exp)
+
+	// Compare the rounded coefficient against the sum at the same exponent.
+	ten := big.NewInt(10)
+
+	rnd := new(big.Int).SetUint64(sig[1])
+	rnd.Lsh(rnd, 64).Or(rnd, new(big.Int).SetUint64(sig[0]))
+
+	tot := new(big.Int).Abs(a.sum)
+
+	if exp > a.exp {
+		rnd.Mul(rnd, new(big.Int).Exp(ten, big.NewInt(int64(exp-a.exp)), nil))
+	} else if exp < a.exp {
+		tot.Mul(tot, new(big.Int).Exp(ten, big.NewInt(int64(a.exp-exp)), nil))
+	}
+
+	return res, rnd.Cmp(tot) == 0
+}
+
+func (a *Accumulator) zero(mode RoundingMode) Decimal {
+	neg := a.anyNeg && !a.anyPos
+	if a.anyNeg && a.anyPos && mode == ToNegativeInf {
+		neg = true
+	}
+
+	return zero(neg)
+}
+
+// accumulatorPart holds a signed value with a coefficient of up to 57 digits.
+type accumulatorPart struct {
+	sig uint192
+	exp int16
+	neg bool
+}
+
+func (p accumulatorPart) add(o accumulatorPart) (accumulatorPart, accumulatorPart, accumulatorPart) {
+	// Align the exponents, preferring to scale up whichever value has the
+	// larger exponent. Only if that would exceed 57 digits are the least
+	// significant digits of the other value split off into a remainder.
+	var rem accumulatorPart
+	if p.exp > o.exp {
+		p.sig, p.exp = accumulatorScale(p.sig, p.exp, o.exp)
+
+		if p.exp > o.exp {
+			o, rem = o.split(p.exp)
+		}
+	} else if o.exp > p.exp {
+		o.sig, o.exp = accumulatorScale(o.sig, o.exp, p.exp)
+
+		if o.exp > p.exp {
+			p, rem = p.split(o.exp)
+		}
+	}
+
+	var carry accumulatorPart
+	if p.neg == o.neg {
+		sum := p.sig.add(o.sig)
+		p.sig = uint192{sum[0], sum[1], sum[2]}
+
+		if sum[3] != 0 || p.sig.cmp(uint192PowersOf10[len(uint192PowersOf10)-1]) >= 0 {
+			var digit uint64
+			sum, digit = sum.div10()
+			carry = accumulatorPart{uint192{digit, 0, 0}, p.exp, p.neg}
+			p.sig = uint192{sum[0], sum[1], sum[2]}
+			p.exp++
+		}
+	} else {
+		var brw uint
+		p.sig, brw = p.sig.sub(o.sig)
+
+		if brw != 0 {
+			p.sig = p.sig.twos()
+			p.neg = o.neg
+		}
+	}
+
+	return p, rem, carry
+}
+
+func (p accumulatorPart) split(exp int16) (accumulatorPart, accumulatorPart) {
+	shift := exp - p.exp
+	rem := accumulatorPart{exp: p.exp, neg: p.neg}
+
+	if shift >= int16(len(uint192PowersOf10)) {
+		rem.sig = p.sig
+		p.sig = uint192{}
+	} else {
+		p.sig, rem.sig = p.sig.div(uint192PowersOf10[shift])
+	}
+
+	p.exp = exp
+	return p, rem
+}
+
+func accumulatorScale(sig uint192, exp, target int16) (uint192, int16) {
+	if sig == (uint192{}) {
+		return sig, target
+	}
+
+	shift := exp - target
+	room := int16(len(uint192PowersOf10) - 2 - sig.log10())
+
+	if shift > room {
+		shift = room
+	}
+
+	if shift <= 0 {
+		return sig, exp
+	}
+
+	prd := sig.mul(uint192PowersOf10[shift])
+	return uint192{prd[0], prd[1], prd[2]}, exp - shift
+}
@@ -0,0 +1,131 @@
+package decimal128
+
+import (
+	"math/big"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestAccumulator(t *testing.T) {
+	t.Parallel()
+
+	values := []struct {
+		vals  []string
+		mode  RoundingMode
+		res   string
+		exact bool
+	}{
+		{nil, ToNearestEven, "0", true},
+		{[]string{"1.5", "2.25"}, ToNearestEven, "3.75", true},
+		{[]string{"1.00", "-1"}, ToNearestEven, "0.00", true},
+		{[]string{"1.00", "-1"}, ToNegativeInf, "-0.00", true},
+		{[]string{"-0", "-0.0"}, ToNearestEven, "-0.0", true},
+		{[]string{"0", "-0"}, ToNearestEven, "0", true},
+		{[]string{"0", "-0"}, ToNegativeInf, "-0", true},
+		{[]string{"5", "-0"}, ToNearestEven, "5", true},
+		{[]string{"0.1", "0.2", "-0.3"}, ToNearestEven, "0.0", true},
+		{[]string{"1e34", "1", "-1e34"}, ToNearestEven, "1", true},
+		{[]string{"1e34", "1", "-1"}, ToNearestEven, "10000000000000000000000000000000000", true},
+		{[]string{"2e34", "1"}, ToNearestEven, "2.000000000000000000000000000000000e34", false},
+		{[]string{"2e34", "1"}, ToPositiveInf, "2.000000000000000000000000000000001e34", false},
+		{[]string{"1e60", "1"}, ToNearestEven, "1.0000000000000000000000000000000000e60", false},
+		{[]string{"1e60", "-1"}, ToZero, "9.999999999999999999999999999999999e59", false},
+		{[]string{"1", "1e60"}, AwayFromZero, "1.0000000000000000000000000000000001e60", false},
+		{[]string{"1e60", "1", "-1e60"}, ToNearestEven, "1", true},
+		{[]string{"12345", "1e60", "-7e-60", "-12345"}, ToZero, "9.999999999999999999999999999999999e59", false},
+		{[]string{"-91841e1983", "-3751893728e923", "69334e1616", "-69334e1616"}, ToNearestEven, "-9.184100000000000000000000000000000e1987", false},
+		{[]string{"1e200", "1e100", "1", "-1e200", "-1e100", "-1"}, ToNearestEven, "0", true},
+		{[]string{"1e6144", "1e-6176", "-1e6144"}, ToNearestEven, "1e-6176", true},
+		{[]string{"9e6144", "9e6144"}, ToNearestEven, "Inf", false},
+		{[]string{"1", "Inf"}, ToNearestEven, "Inf", false},
+		{[]string{"-Inf", "1", "-Inf"}, ToNearestEven, "-Inf", false},
+		{[]string{"Inf", "-Inf"}, ToNearestEven, "NaN", false},
+		{[]string{"1", "NaN", "Inf"}, ToNearestEven, "NaN", false},
+	}
+
+	for _, val := range values {
+		var acc Accumulator
+		for _, v := range val.vals {
+			acc.Add(MustParse(v))
+		}
+
+		want := MustParse(val.res)
+		res, exact := acc.ResultWithMode(val.mode)
+
+		if !resultEqual(res, want) || !res.SameQuantum(want) || exact != val.exact {
+			t.Errorf("Accumulator%v.ResultWithMode(%v) = (%v, %t), want (%v, %t)", val.vals, val.mode, res, exact, want, val.exact)
+		}
+
+		acc.Reset()
+		res, exact = acc.Result()
+
+		if !res.Equal(zero(false)) || res.Signbit() || !exact {
+			t.Errorf("Accumulator%v.Reset().Result() = (%v, %t), want (0, true)", val.vals, res, exact)
+		}
+	}
+}
+
+func TestAccumulatorRandom(t *testing.T) {
+	t.Parallel()
+
+	rnd := rand.New(rand.NewPCG(1, 2))
+
+	for i := range 3000 {
+		// Cycle between sums whose digits all fit within the accumulator and
+		// sums spread over far more digits than it holds.
+		span := []int{20, 200, 2000}[i%3]
+
+		var acc Accumulator
+		vals := make([]Decimal, 1+rnd.IntN(20))
+		sum := new(big.Rat)
+		var anyPos, anyNeg bool
+
+		for j := range vals {
+			sig := uint128{rnd.Uint64(), rnd.Uint64N(0x0001_ed09_bead_87c0)}
+			if rnd.IntN(2) == 0 {
+				sig = uint128{rnd.Uint64N(1_000_000), 0}
+			}
+
+			vals[j] = compose(rnd.IntN(2) == 0, sig, int16(exponentBias-rnd.IntN(span)))
+
+			// Cancel out some of the values added earlier.
+			if j > 0 && rnd.IntN(4) == 0 {
+				vals[j] = vals[rnd.IntN(j)].Neg()
+			}
+
+			acc.Add(vals[j])
+			sum.Add(sum, oracleRat(vals[j]))
+
+			if vals[j].Signbit() {
+				anyNeg = true
+			} else {
+				anyPos = true
+			}
+		}
+
+		exact := func(r *big.Rat) bool {
+			return r.Cmp(sum) == 0
+		}
+
+		for _, mode := range roundingModes {
+			res, resExact := acc.ResultWithMode(mode)
+
+			want, ok := oracleRound(new(big.Float).SetPrec(8192).SetRat(sum), 8192, exact, mode)
+			if !ok {
+				t.Fatalf("oracleRound(%v) undecided", vals)
+			}
+
+			if sum.Sign() == 0 {
+				want = zero(anyNeg && (!anyPos || mode == ToNegativeInf))
+			}
+
+			if !resultEqual(res, want) {
+				t.Errorf("Accumulator%v.ResultWithMode(%v) = %v, want %v", vals, mode, res, want)
+			}
+
+			if resExact != exact(oracleRat(res)) {
+				t.Errorf("Accumulator%v.ResultWithMode(%v) exact = %t, want %t", vals, mode, resExact, !resExact)
+			}
+		}
+	}
+}
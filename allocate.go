@@ -0,0 +1,182 @@
+package decimal128
+
+import (
+	"math/big"
+	"slices"
+)
+
+// Allocate splits total into parts proportional to ratios, with each part
+// rounded to dp decimal places, such that the parts always sum exactly to
+// total. Each part is first rounded towards zero, and any units left over
+// are then handed out one at a time to the parts with the largest remainders,
+// using the earliest part to break ties. The parts all have dp decimal places
+// and the same sign as total, and a part with a ratio of zero is always zero.
+//
+// Allocate returns nil if total is NaN or infinite, if it cannot be
+// represented exactly with dp decimal places, if ratios is empty, if any ratio
+// is negative, NaN, or infinite, or if every ratio is zero.
+func Allocate(total Decimal, ratios []Decimal, dp int) []Decimal {
+	if len(ratios) == 0 {
+		return nil
+	}
+
+	units, exp, ok := allocateUnits(total, dp)
+	if !ok {
+		return nil
+	}
+
+	// Zero ratios are skipped, as they have no weight and are usually stored
+	// with the smallest exponent, which would make the other weights huge.
+	minExp := 0
+	found := false
+	for _, r := range ratios {
+		if r.isSpecial() || (r.Signbit() && !r.IsZero()) {
+			return nil
+		}
+
+		if r.IsZero() {
+			continue
+		}
+
+		if rExp := r.Exponent(); !found || rExp < minExp {
+			minExp = rExp
+			found = true
+		}
+	}
+
+	// Scale the ratios to integer weights sharing a common exponent.
+	weights := make([]big.Int, len(ratios))
+	sum := new(big.Int)
+	ten := big.NewInt(10)
+	tmp := new(big.Int)
+
+	for i, r := range ratios {
+		r.Coefficient(&weights[i])
+		weights[i].Abs(&weights[i])
+
+		if shift := r.Exponent() - minExp; shift > 0 && weights[i].Sign() != 0 {
+			tmp.Exp(ten, big.NewInt(int64(shift)), nil)
+			weights[i].Mul(&weights[i], tmp)
+		}
+
+		sum.Add(sum, &weights[i])
+	}
+
+	if sum.Sign() == 0 {
+		return nil
+	}
+
+	return allocate(total.Signbit(), units, weights, sum, exp)
+}
+
+// AllocateEven splits total into n equal parts, with each part rounded to dp
+// decimal places, such that the parts always sum exactly to total. Any units
+// left over are handed out one at a time to the earliest parts, so no two
+// parts differ by more than one unit in the last decimal place.
+//
+// AllocateEven returns nil if total is NaN or infinite, if it cannot be
+// represented exactly with dp decimal places, or if n is not positive.
+func AllocateEven(total Decimal, n int, dp int) []Decimal {
+	if n <= 0 {
+		return nil
+	}
+
+	units, exp, ok := allocateUnits(total, dp)
+	if !ok {
+		return nil
+	}
+
+	weights := make([]big.Int, n)
+	for i := range weights {
+		weights[i].SetInt64(1)
+	}
+
+	return allocate(total.Signbit(), units, weights, big.NewInt(int64(n)), exp)
+}
+
+func allocate(neg bool, units uint128, weights []big.Int, sum *big.Int, exp int16) []Decimal {
+	total := new(big.Int).SetUint64(units[1])
+	total.Lsh(total, 64).Or(total, new(big.Int).SetUint64(units[0]))
+
+	parts := make([]uint128, len(weights))
+	rems := make([]big.Int, len(weights))
+	left := units
+	quo := new(big.Int)
+	mask := new(big.Int).SetUint64(0xffff_ffff_ffff_ffff)
+	tmp := new(big.Int)
+
+	for i := range weights {
+		quo.Mul(total, &weights[i])
+		quo.QuoRem(quo, sum, &rems[i])
+
+		// Every share is no larger than the total, so fits in 128 bits.
+		parts[i] = uint128{tmp.And(quo, mask).Uint64(), tmp.Rsh(quo, 64).Uint64()}
+		left, _ = left.sub(parts[i])
+	}
+
+	// The remainders sum to a multiple of the weight sum that is less than the
+	// number of parts, so each leftover unit goes to a different part.
+	if left != (uint128{}) {
+		order := make([]int, len(weights))
+		for i := range order {
+			order[i] = i
+		}
+
+		slices.SortStableFunc(order, func(a, b int) int {
+			return rems[b].Cmp(&rems[a])
+		})
+
+		for _, i := range order[:left[0]] {
+			parts[i] = parts[i].add64(1)
+		}
+	}
+
+	res := make([]Decimal, len(parts))
+	for i, sig := range parts {
+		res[i] = compose(neg, sig, exp)
+	}
+
+	return res
+}
+
+func allocateUnits(total Decimal, dp int) (uint128, int16, bool) {
+	if total.isSpecial() {
+		return uint128{}, 0, false
+	}
+
+	if dp > exponentBias || dp < exponentBias-maxBiasedExponent {
+		return uint128{}, 0, false
+	}
+
+	sig, exp := total.decompose()
+	target := int16(exponentBias - dp)
+
+	if sig[0]|sig[1] == 0 {
+		return sig, target, true
+	}
+
+	// Rescale the coefficient to the target exponent, failing if that would
+	// either discard non-zero digits or need more than 34 digits.
+	for ; exp > target; exp-- {
+		sig = sig.mul64(10)
+
+		if sig.cmp(uint128{0x378d_8e64_0000_0000, 0x0001_ed09_bead_87c0}) >= 0 {
+			return uint128{}, 0, false
+		}
+	}
+
+	for ; exp < target; exp++ {
+		var rem uint64
+		sig, rem = sig.div10()
+
+		if rem != 0 {
+			return uint128{}, 0, false
+		}
+	}
+
+	if sig.cmp(uint128{0x378d_8e64_0000_0000, 0x0001_ed09_bead_87c0}) >= 0 {
+		return uint128{}, 0, false
+	}
+
+	return sig, target, true
+}
@@ -0,0 +1,160 @@
+package decimal128
+
+import (
+	"math/big"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestAllocate(t *testing.T) {
+	t.Parallel()
+
+	values := []struct {
+		total  string
+		ratios []string
+		dp     int
+		res    []string
+	}{
+		{"100", []string{"1", "1", "1"}, 2, []string{"33.34", "33.33", "33.33"}},
+		{"0.05", []string{"3", "7"}, 2, []string{"0.02", "0.03"}},
+		{"-10", []string{"1", "2"}, 2, []string{"-3.33", "-6.67"}},
+		{"10", []string{"0.5", "0.25", "0.25"}, 0, []string{"5", "3", "2"}},
+		{"100", []string{"1e2", "1"}, 2, []string{"99.01", "0.99"}},
+		{"1", []string{"0", "1"}, 2, []string{"0", "1"}},
+		{"1", []string{"-0", "1", "0"}, 2, []string{"0", "1", "0"}},
+		{"100", []string{"0", "1.5", "0.5"}, 2, []string{"0", "75", "25"}},
+		{"1", []string{"0.000", "0.1", "1e3"}, 4, []string{"0", "0.0001", "0.9999"}},
+		{"0", []string{"1", "2"}, 2, []string{"0", "0"}},
+		{"-0", []string{"1", "2"}, 2, []string{"-0", "-0"}},
+		{"10", []string{"1", "1", "1"}, -1, []string{"10", "0", "0"}},
+		{"1.50", []string{"1", "1"}, 1, []string{"0.8", "0.7"}},
+		{"9999999999999999999999999999999999", []string{"1", "1"}, 0, []string{"5000000000000000000000000000000000", "4999999999999999999999999999999999"}},
+		{"1.005", []string{"1"}, 2, nil},
+		{"1e33", []string{"1"}, 2, nil},
+		{"1", []string{"1"}, 6177, nil},
+		{"1", nil, 2, nil},
+		{"1", []string{"0", "-0"}, 2, nil},
+		{"1", []string{"-1", "2"}, 2, nil},
+		{"1", []string{"1", "Inf"}, 2, nil},
+		{"1", []string{"1", "NaN"}, 2, nil},
+		{"Inf", []string{"1"}, 2, nil},
+		{"NaN", []string{"1"}, 2, nil},
+	}
+
+	for _, val := range values {
+		total := MustParse(val.total)
+		ratios := make([]Decimal, len(val.ratios))
+		for i, r := range val.ratios {
+			ratios[i] = MustParse(r)
+		}
+
+		res := Allocate(total, ratios, val.dp)
+
+		if !allocateEqual(res, val.res, val.dp) {
+			t.Errorf("Allocate(%v, %v, %d) = %v, want %v", total, ratios, val.dp, res, val.res)
+		}
+	}
+}
+
+func TestAllocateEven(t *testing.T) {
+	t.Parallel()
+
+	values := []struct {
+		total string
+		n     int
+		dp    int
+		res   []string
+	}{
+		{"100", 3, 2, []string{"33.34", "33.33", "33.33"}},
+		{"0.02", 3, 2, []string{"0.01", "0.01", "0"}},
+		{"-1", 4, 2, []string{"-0.25", "-0.25", "-0.25", "-0.25"}},
+		{"-1", 3, 0, []string{"-1", "-0", "-0"}},
+		{"7", 1, 3, []string{"7"}},
+		{"5", 0, 2, nil},
+		{"0.001", 2, 2, nil},
+		{"NaN", 2, 2, nil},
+	}
+
+	for _, val := range values {
+		total := MustParse(val.total)
+		res := AllocateEven(total, val.n, val.dp)
+
+		if !allocateEqual(res, val.res, val.dp) {
+			t.Errorf("AllocateEven(%v, %d, %d) = %v, want %v", total, val.n, val.dp, res, val.res)
+		}
+	}
+}
+
+func TestAllocateRandom(t *testing.T) {
+	t.Parallel()
+
+	rnd := rand.New(rand.NewPCG(1, 2))
+
+	for range 2000 {
+		dp := rnd.IntN(5)
+		total := compose(rnd.IntN(2) == 0, uint128{rnd.Uint64(), rnd.Uint64N(0x0000_0001_0000_0000)}, int16(exponentBias-rnd.IntN(dp+1)))
+
+		ratios := make([]Decimal, 1+rnd.IntN(20))
+		for i := range ratios {
+			ratios[i] = compose(false, uint128{rnd.Uint64N(1000), 0}, int16(exponentBias-rnd.IntN(6)))
+		}
+
+		res := Allocate(total, ratios, dp)
+
+		ratioSum := new(big.Rat)
+		for _, r := range ratios {
+			ratioSum.Add(ratioSum, oracleRat(r))
+		}
+
+		if ratioSum.Sign() == 0 {
+			if res != nil {
+				t.Errorf("Allocate(%v, %v, %d) = %v, want nil", total, ratios, dp, res)
+			}
+
+			continue
+		}
+
+		if len(res) != len(ratios) {
+			t.Fatalf("Allocate(%v, %v, %d) = %v, want %d parts", total, ratios, dp, res, len(ratios))
+		}
+
+		totalRat := oracleRat(total)
+		unit := new(big.Rat).SetFrac64(1, 1)
+		for range dp {
+			unit.Quo(unit, big.NewRat(10, 1))
+		}
+
+		sum := new(big.Rat)
+		for i, part := range res {
+			sum.Add(sum, oracleRat(part))
+
+			if part.Exponent() != -dp {
+				t.Errorf("Allocate(%v, %v, %d)[%d] = %v, want %d decimal places", total, ratios, dp, i, part, dp)
+			}
+
+			// Each part is within one unit of its exact share.
+			share := new(big.Rat).Mul(totalRat, oracleRat(ratios[i]))
+			share.Quo(share, ratioSum)
+			share.Sub(share, oracleRat(part))
+
+			if share.Abs(share).Cmp(unit) >= 0 {
+				t.Errorf("Allocate(%v, %v, %d)[%d] = %v, too far from exact share", total, ratios, dp, i, part)
+			}
+		}
+
+		if sum.Cmp(totalRat) != 0 {
+			t.Errorf("Allocate(%v, %v, %d) = %v, sums to %v, want %v", total, ratios, dp, res, sum.FloatString(dp), total)
+		}
+	}
+}
+
+func allocateEqual(res []Decimal, want []string, dp int) bool {
+	if want == nil {
+		return res == nil
+	}
+
+	return slices.EqualFunc(res, want, func(x Decimal, y string) bool {
+		return resultEqual(x, MustParse(y)) && x.Exponent() == -dp
+	})
+}
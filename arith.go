@@ -1,6 +1,209 @@
 package decimal128
 
-import "math/bits"
+import (
+	"math"
+	"math/bits"
+)
+
+// FMA returns x * y + z, computed with only one rounding using the
+// [DefaultRoundingMode]. That is, FMA returns the fused multiply-add of x, y,
+// and z.
+func FMA(x, y, z Decimal) Decimal {
+	return FMAWithMode(x, y, z, DefaultRoundingMode)
+}
+
+// FMAWithMode returns x * y + z, computed with only one rounding using the
+// provided rounding mode. That is, FMAWithMode returns the fused multiply-add
+// of x, y, and z.
+func FMAWithMode(x, y, z Decimal, mode RoundingMode) Decimal {
+	pNeg := x.Signbit() != y.Signbit()
+
+	if x.isSpecial() || y.isSpecial() || z.isSpecial() {
+		if x.IsNaN() {
+			return x.quiet()
+		}
+
+		if y.IsNaN() {
+			return y.quiet()
+		}
+
+		if z.IsNaN() {
+			return z.quiet()
+		}
+
+		if x.isInf() || y.isInf() {
+			if x.IsZero() || y.IsZero() {
+				return fmaNaN(x, y, z)
+			}
+
+			if z.isInf() && z.Signbit() != pNeg {
+				return fmaNaN(x, y, z)
+			}
+
+			return inf(pNeg)
+		}
+
+		return z
+	}
+
+	xSig, xExp := x.decompose()
+	ySig, yExp := y.decompose()
+	zSig, zExp := z.decompose()
+
+	pSig := xSig.mul(ySig)
+	pExp := (xExp - exponentBias) + (yExp - exponentBias) + exponentBias
+	zNeg := z.Signbit()
+
+	if pSig[0]|pSig[1]|pSig[2]|pSig[3] == 0 {
+		if zSig[0]|zSig[1] == 0 {
+			if pNeg == zNeg {
+				return zero(pNeg)
+			}
+
+			return zero(mode == ToNegativeInf)
+		}
+
+		return z
+	}
+
+	if zSig[0]|zSig[1] == 0 {
+		sig, exp := mode.reduce256(pNeg, pSig, pExp, 0)
+
+		if exp > maxBiasedExponent {
+			return inf(pNeg)
+		}
+
+		return compose(pNeg, sig, exp)
+	}
+
+	pSig384 := uint384{pSig[0], pSig[1], pSig[2], pSig[3], 0, 0}
+	zSig384 := uint384{zSig[0], zSig[1], 0, 0, 0, 0}
+
+	exp := pExp - zExp
+	trunc := int8(0)
+
+	if exp < 0 {
+		for exp <= -19 && zSig384[5] == 0 {
+			zSig384 = zSig384.mul64(10_000_000_000_000_000_000)
+			zExp -= 19
+			exp += 19
+		}
+
+		for exp <= -4 && zSig384[5] <= 0x0002_7fff_ffff_ffff {
+			zSig384 = zSig384.mul64(10_000)
+			zExp -= 4
+			exp += 4
+		}
+
+		for exp < 0 && zSig384[5] <= 0x0002_7fff_ffff_ffff {
+			zSig384 = zSig384.mul64(10)
+			zExp--
+			exp++
+		}
+
+		if exp < -maxDigits*2 {
+			pSig384 = uint384{}
+			trunc = 1
+			exp = 0
+		}
+
+		for exp <= -19 {
+			var rem uint64
+			pSig384, rem = pSig384.div1e19()
+			if rem != 0 {
+				trunc = 1
+			}
+
+			exp += 19
+		}
+
+		for exp < 0 {
+			var rem uint64
+			pSig384, rem = pSig384.div10()
+			if rem != 0 {
+				trunc = 1
+			}
+
+			exp++
+		}
+
+		pExp = zExp
+	} else if exp > 0 {
+		for exp >= 19 && pSig384[5] == 0 {
+			pSig384 = pSig384.mul64(10_000_000_000_000_000_000)
+			pExp -= 19
+			exp -= 19
+		}
+
+		for exp >= 4 && pSig384[5] <= 0x0002_7fff_ffff_ffff {
+			pSig384 = pSig384.mul64(10_000)
+			pExp -= 4
+			exp -= 4
+		}
+
+		for exp > 0 && pSig384[5] <= 0x0002_7fff_ffff_ffff {
+			pSig384 = pSig384.mul64(10)
+			pExp--
+			exp--
+		}
+
+		if exp > maxDigits {
+			zSig384 = uint384{}
+			trunc = -1
+			exp = 0
+		}
+
+		for exp >= 19 {
+			var rem uint64
+			zSig384, rem = zSig384.div1e19()
+			if rem != 0 {
+				trunc = -1
+			}
+
+			exp -= 19
+		}
+
+		for exp > 0 {
+			var rem uint64
+			zSig384, rem = zSig384.div10()
+			if rem != 0 {
+				trunc = -1
+			}
+
+			exp--
+		}
+	}
+
+	neg := pNeg
+
+	var sig uint384
+	if pNeg == zNeg {
+		sig = pSig384.add(zSig384)
+
+		if trunc == -1 {
+			trunc = 1
+		}
+	} else {
+		var brw uint
+		sig, brw = pSig384.sub(zSig384)
+
+		if brw != 0 {
+			sig = sig.twos()
+			neg = !neg
+			trunc *= -1
+		} else if sig[0]|sig[1]|sig[2]|sig[3]|sig[4]|sig[5] == 0 {
+			return zero(mode == ToNegativeInf)
+		}
+	}
+
+	sig128, exp := mode.reduce384(neg, sig, pExp, trunc)
+
+	if exp > maxBiasedExponent {
+		return inf(neg)
+	}
+
+	return compose(neg, sig128, exp)
+}
 
 // Add adds d and o, rounded using the [DefaultRoundingMode], and returns the
 // result.
@@ -13,11 +216,11 @@ func (d Decimal) Add(o Decimal) Decimal {
 func (d Decimal) AddWithMode(o Decimal, mode RoundingMode) Decimal {
 	if d.isSpecial() || o.isSpecial() {
 		if d.IsNaN() {
-			return d
+			return d.quiet()
 		}
 
 		if o.IsNaN() {
-			return o
+			return o.quiet()
 		}
 
 		if d.isInf() {
@@ -43,6 +246,99 @@ func (d Decimal) AddWithMode(o Decimal, mode RoundingMode) Decimal {
 	return d.add(o, mode, false)
 }
 
+// EuclidMod returns the Euclidean modulus of d and o, the remainder of
+// dividing d by o that is always greater than or equal to zero and less than
+// the magnitude of o. For positive values of o, this is the remainder that
+// corresponds to the quotient returned by [Decimal.FloorQuo].
+//
+// The result is exact unless d is negative and the magnitude of o is very much
+// larger than that of d, in which case the result is rounded towards zero.
+// If either value is NaN, d is infinite, or o is zero, the result is NaN. If o
+// is infinite, the result is d when d is greater than or equal to zero, and
+// NaN when d is less than zero, as no such remainder exists.
+func (d Decimal) EuclidMod(o Decimal) Decimal {
+	res := d.rem(o, payloadOpEuclidMod, false)
+
+	if res.IsNaN() || !res.Signbit() {
+		return res
+	}
+
+	if res.IsZero() {
+		return res.Neg()
+	}
+
+	if o.isInf() {
+		return nan(payloadOpEuclidMod, payloadVal(d), payloadVal(o))
+	}
+
+	return res.AddWithMode(Abs(o), ToZero)
+}
+
+// FloorQuo returns the greatest integer value less than or equal to the result
+// of dividing d by o. If the result is too large to be represented exactly, it
+// is rounded towards negative infinity.
+//
+// If either value is NaN, both values are infinite, or both values are zero,
+// the result is NaN.
+func (d Decimal) FloorQuo(o Decimal) Decimal {
+	if d.isSpecial() || o.isSpecial() {
+		if d.IsNaN() {
+			return d.quiet()
+		}
+
+		if o.IsNaN() {
+			return o.quiet()
+		}
+
+		if d.isInf() {
+			if o.isInf() {
+				return nan(payloadOpFloorQuo, payloadVal(d), payloadVal(o))
+			}
+
+			return inf(d.Signbit() != o.Signbit())
+		}
+
+		return zero(d.Signbit() != o.Signbit())
+	}
+
+	if o.IsZero() {
+		if d.IsZero() {
+			return nan(payloadOpFloorQuo, payloadVal(d), payloadVal(o))
+		}
+
+		return inf(d.Signbit() != o.Signbit())
+	}
+
+	if d.IsZero() {
+		return zero(d.Signbit() != o.Signbit())
+	}
+
+	res := d.QuoWithMode(o, ToNegativeInf)
+
+	// A quotient that is too small to be represented is rounded to zero
+	// regardless of the rounding mode, but the floor of a negative quotient
+	// is always -1.
+	if res.IsZero() {
+		if res.Signbit() {
+			return one(true)
+		}
+
+		return res
+	}
+
+	return res.Floor(0)
+}
+
+// Mod returns the remainder of dividing d by o, where the quotient has been
+// truncated towards zero, equivalent to the C fmod function. The result has
+// the same sign as d and is always exact.
+//
+// If either value is NaN, d is infinite, or o is zero, the result is NaN. If o
+// is infinite and d is finite, the result is d.
+func (d Decimal) Mod(o Decimal) Decimal {
+	return d.rem(o, payloadOpMod, false)
+}
+
 // Mul multiplies d and o, rounding using the [DefaultRoundingMode], and
 // returns the result.
 func (d Decimal) Mul(o Decimal) Decimal {
@@ -54,11 +350,11 @@ func (d Decimal) Mul(o Decimal) Decimal {
 func (d Decimal) MulWithMode(o Decimal, mode RoundingMode) Decimal {
 	if d.isSpecial() || o.isSpecial() {
 		if d.IsNaN() {
-			return d
+			return d.quiet()
 		}
 
 		if o.IsNaN() {
-			return o
+			return o.quiet()
 		}
 
 		if !d.isSpecial() {
@@ -96,36 +392,7 @@ func (d Decimal) MulWithMode(o Decimal, mode RoundingMode) Decimal {
 		return inf(d.Signbit() != o.Signbit())
 	}
 
-	dSig, dExp := d.decompose()
-	oSig, oExp := o.decompose()
-
-	exp := (dExp - exponentBias) + (oExp - exponentBias) + exponentBias
-	neg := d.Signbit() != o.Signbit()
-
-	var sig uint128
-	if dSig[1]|oSig[1] == 0 {
-		sig1, sig0 := bits.Mul64(dSig[0], oSig[0])
-
-		if sig0|sig1 == 0 {
-			return zero(neg)
-		}
-
-		sig, exp = mode.reduce128(neg, uint128{sig0, sig1}, exp, 0)
-	} else {
-		sig256 := dSig.mul(oSig)
-
-		if sig256[0]|sig256[1]|sig256[2]|sig256[3] == 0 {
-			return zero(neg)
-		}
-
-		sig, exp = mode.reduce256(neg, sig256, exp, 0)
-	}
-
-	if exp > maxBiasedExponent {
-		return inf(neg)
-	}
-
-	return compose(neg, sig, exp)
+	return d.mul(o, mode)
 }
 
 // Pow raises d to the power of o, rounding using the [DefaultRoundingMode],
@@ -136,7 +403,17 @@ func (d Decimal) Pow(o Decimal) Decimal {
 
 // PowWithMode raises d to the power of o, rounding using the provided rounding
 // mode, and returns the result.
+//
+// If the result can be represented exactly it is returned exactly.
 func (d Decimal) PowWithMode(o Decimal, mode RoundingMode) Decimal {
+	if d.IsSignalingNaN() {
+		return d.quiet()
+	}
+
+	if o.IsSignalingNaN() {
+		return o.quiet()
+	}
+
 	if o.IsZero() {
 		return one(false)
 	}
@@ -156,11 +433,11 @@ func (d Decimal) PowWithMode(o Decimal, mode RoundingMode) Decimal {
 	}
 
 	if d.IsNaN() {
-		return d
+		return d.quiet()
 	}
 
 	if o.IsNaN() {
-		return o
+		return o.quiet()
 	}
 
 	dNeg := d.Signbit()
@@ -370,10 +647,12 @@ func (d Decimal) PowWithMode(o Decimal, mode RoundingMode) Decimal {
 		return compose(neg, dSig, exp+exponentBias)
 	}
 
-	inv, res, trunc := decomposed192{
+	val := decomposed192{
 		sig: uint192{dSig[0], dSig[1], 0},
 		exp: dExp - exponentBias,
-	}.log()
+	}
+
+	inv, res, trunc := val.log()
 
 	if res.sig[0]|res.sig[1]|res.sig[2] == 0 {
 		return one(neg)
@@ -425,6 +704,46 @@ func (d Decimal) PowWithMode(o Decimal, mode RoundingMode) Decimal {
 		trunc *= -1
 	}
 
+	if trunc != 0 && oSig[1] == 0 {
+		// The result may be exact, in which case res is within a tiny
+		// distance of it. Write |o| as a fraction p/q in lowest terms and
+		// check whether the result raised to the power of q is d**p.
+		p, q := oSig[0], uint64(1)
+		for exp := oExp; exp != exponentBias && p != 0; {
+			if exp > exponentBias {
+				if p > math.MaxUint64/10 {
+					p = 0
+				}
+
+				p *= 10
+				exp--
+			} else {
+				if q > math.MaxUint64/10 {
+					p = 0
+				}
+
+				q *= 10
+				exp++
+			}
+		}
+
+		for p%2 == 0 && q%2 == 0 {
+			p /= 2
+			q /= 2
+		}
+
+		for p%5 == 0 && q%5 == 0 {
+			p /= 5
+			q /= 5
+		}
+
+		if p != 0 {
+			if est, ok := res.exactPow(val, p, q, oNeg); ok {
+				res, trunc = est, 0
+			}
+		}
+	}
+
 	sig, exp := mode.reduce192(neg, res.sig, res.exp+exponentBias, trunc)
 
 	if exp > maxBiasedExponent {
@@ -445,11 +764,11 @@ func (d Decimal) Quo(o Decimal) Decimal {
 func (d Decimal) QuoWithMode(o Decimal, mode RoundingMode) Decimal {
 	if d.isSpecial() || o.isSpecial() {
 		if d.IsNaN() {
-			return d
+			return d.quiet()
 		}
 
 		if o.IsNaN() {
-			return o
+			return o.quiet()
 		}
 
 		if d.isInf() {
@@ -624,11 +943,11 @@ func (d Decimal) QuoRem(o Decimal) (Decimal, Decimal) {
 func (d Decimal) QuoRemWithMode(o Decimal, mode RoundingMode) (Decimal, Decimal) {
 	if d.isSpecial() || o.isSpecial() {
 		if d.IsNaN() {
-			return d, d
+			return d.quiet(), d.quiet()
 		}
 
 		if o.IsNaN() {
-			return o, o
+			return o.quiet(), o.quiet()
 		}
 
 		if d.isInf() {
@@ -859,6 +1178,17 @@ func (d Decimal) QuoRemWithMode(o Decimal, mode RoundingMode) (Decimal, Decimal)
 	return quo, compose(rneg, rsig, rexp)
 }
 
+// Remainder returns the remainder of dividing d by o as defined by IEEE 754,
+// where the quotient has been rounded to the nearest integer, with ties
+// rounded to the nearest even integer. The magnitude of the result is at most
+// half the magnitude of o, and the result is always exact.
+//
+// If either value is NaN, d is infinite, or o is zero, the result is NaN. If o
+// is infinite and d is finite, the result is d.
+func (d Decimal) Remainder(o Decimal) Decimal {
+	return d.rem(o, payloadOpRemainder, true)
+}
+
 // Sub subtracts o from d, rounding using the [DefaultRoundingMode], and
 // returns the result.
 func (d Decimal) Sub(o Decimal) Decimal {
@@ -870,11 +1200,11 @@ func (d Decimal) Sub(o Decimal) Decimal {
 func (d Decimal) SubWithMode(o Decimal, mode RoundingMode) Decimal {
 	if d.isSpecial() || o.isSpecial() {
 		if d.IsNaN() {
-			return d
+			return d.quiet()
 		}
 
 		if o.IsNaN() {
-			return o
+			return o.quiet()
 		}
 
 		if d.isInf() {
@@ -1176,3 +1506,124 @@ func (d Decimal) add(o Decimal, mode RoundingMode, subtract bool) Decimal {
 
 	return compose(neg, sig, exp)
 }
+
+func (d Decimal) mul(o Decimal, mode RoundingMode) Decimal {
+	dSig, dExp := d.decompose()
+	oSig, oExp := o.decompose()
+
+	exp := (dExp - exponentBias) + (oExp - exponentBias) + exponentBias
+	neg := d.Signbit() != o.Signbit()
+
+	var sig uint128
+	if dSig[1]|oSig[1] == 0 {
+		sig1, sig0 := bits.Mul64(dSig[0], oSig[0])
+
+		if sig0|sig1 == 0 {
+			return zero(neg)
+		}
+
+		sig, exp = mode.reduce128(neg, uint128{sig0, sig1}, exp, 0)
+	} else {
+		sig256 := dSig.mul(oSig)
+
+		if sig256[0]|sig256[1]|sig256[2]|sig256[3] == 0 {
+			return zero(neg)
+		}
+
+		sig, exp = mode.reduce256(neg, sig256, exp, 0)
+	}
+
+	if exp > maxBiasedExponent {
+		return inf(neg)
+	}
+
+	return compose(neg, sig, exp)
+}
+
+func (d Decimal) rem(o Decimal, op Payload, nearest bool) Decimal {
+	if d.isSpecial() || o.isSpecial() {
+		if d.IsNaN() {
+			return d.quiet()
+		}
+
+		if o.IsNaN() {
+			return o.quiet()
+		}
+
+		if d.isInf() {
+			return nan(op, payloadVal(d), payloadVal(o))
+		}
+
+		return d
+	}
+
+	dSig, dExp := d.decompose()
+	oSig, oExp := o.decompose()
+
+	if oSig[0]|oSig[1] == 0 {
+		return nan(op, payloadVal(d), payloadVal(o))
+	}
+
+	neg := d.Signbit()
+
+	var sig uint128
+	var exp int16
+	var odd bool
+	var huge bool
+
+	if dExp >= oExp {
+		// The remainder is calculated modulo 2*o so that the parity of the
+		// quotient is known when rounding to nearest, and the coefficient of d
+		// is scaled up to the exponent of o one power of 10 at a time, reducing
+		// modulo 2*o after each step to keep the intermediate values small.
+		mod := oSig.lsh(1)
+		mod192 := uint192{mod[0], mod[1], 0}
+
+		_, rem := uint192{dSig[0], dSig[1], 0}.div(mod192)
+
+		for n := int(dExp - oExp); n > 0; {
+			step := min(n, 19)
+			_, rem = rem.mul64(uint128PowersOf10[step][0]).div(mod192)
+			n -= step
+		}
+
+		sig = uint128{rem[0], rem[1]}
+		exp = oExp
+
+		if sig.cmp(oSig) >= 0 {
+			sig, _ = sig.sub(oSig)
+			odd = true
+		}
+	} else {
+		exp = dExp
+
+		if n := int(oExp - dExp); n < len(uint128PowersOf10) {
+			prd := oSig.mul(uint128PowersOf10[n])
+			huge = prd[2]|prd[3] != 0
+			oSig = uint128{prd[0], prd[1]}
+		} else {
+			huge = true
+		}
+
+		if huge {
+			sig = dSig
+		} else {
+			var quo uint128
+			quo, sig = dSig.div(oSig)
+			odd = quo[0]&1 != 0
+		}
+	}
+
+	if nearest && !huge {
+		if c := sig.lsh(1).cmp(oSig); c > 0 || c == 0 && odd {
+			sig, _ = oSig.sub(sig)
+			neg = !neg
+		}
+	}
+
+	return compose(neg, sig, exp)
+}
+
+func fmaNaN(x, y, z Decimal) Decimal {
+	return Decimal{uint64(payloadOpFMA | payloadVal(x)<<8 | payloadVal(y)<<16 | payloadVal(z)<<24), 0x7c00_0000_0000_0000}
+}
@@ -28,6 +28,63 @@ func TestDecimalAdd(t *testing.T) {
 	}
 }
 
+func TestDecimalEuclidMod(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var lhs Decimal
+	var rhs Decimal
+	var res Decimal
+
+	for r.scan("euclidmod(%v, %v) = %v\n", &lhs, &rhs, &res) {
+		val := lhs.EuclidMod(rhs)
+
+		if !resultEqual(val, res) {
+			t.Errorf("%v.EuclidMod(%v) = %v, want %v", lhs, rhs, val, res)
+		}
+	}
+}
+
+func TestDecimalFloorQuo(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var lhs Decimal
+	var rhs Decimal
+	var res Decimal
+
+	for r.scan("floorquo(%v, %v) = %v\n", &lhs, &rhs, &res) {
+		val := lhs.FloorQuo(rhs)
+
+		if !resultEqual(val, res) {
+			t.Errorf("%v.FloorQuo(%v) = %v, want %v", lhs, rhs, val, res)
+		}
+	}
+}
+
+func TestDecimalMod(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var lhs Decimal
+	var rhs Decimal
+	var res Decimal
+
+	for r.scan("mod(%v, %v) = %v\n", &lhs, &rhs, &res) {
+		val := lhs.Mod(rhs)
+
+		if !resultEqual(val, res) {
+			t.Errorf("%v.Mod(%v) = %v, want %v", lhs, rhs, val, res)
+		}
+	}
+}
+
 func TestDecimalMul(t *testing.T) {
 	t.Parallel()
 
@@ -114,6 +171,25 @@ func TestDecimalQuoRem(t *testing.T) {
 	}
 }
 
+func TestDecimalRemainder(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var lhs Decimal
+	var rhs Decimal
+	var res Decimal
+
+	for r.scan("remainder(%v, %v) = %v\n", &lhs, &rhs, &res) {
+		val := lhs.Remainder(rhs)
+
+		if !resultEqual(val, res) {
+			t.Errorf("%v.Remainder(%v) = %v, want %v", lhs, rhs, val, res)
+		}
+	}
+}
+
 func TestDecimalSub(t *testing.T) {
 	t.Parallel()
 
@@ -135,6 +211,28 @@ func TestDecimalSub(t *testing.T) {
 	}
 }
 
+func TestFMA(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var x Decimal
+	var y Decimal
+	var z Decimal
+	var res testDataResult
+
+	for r.scan("fma(%v, %v, %v) = %v\n", &x, &y, &z, &res) {
+		for _, mode := range roundingModes {
+			fma := FMAWithMode(x, y, z, mode)
+
+			if !res.equal(fma, mode) {
+				t.Errorf("FMAWithMode(%v, %v, %v, %v) = %v, want %v", x, y, z, mode, fma, res.result(mode))
+			}
+		}
+	}
+}
+
 func FuzzArith(f *testing.F) {
 	values := []float64{
 		0.0,
@@ -0,0 +1,318 @@
+package decimal128
+
+import "math/bits"
+
+// AddSlices sets each element of dst to the sum of the corresponding elements
+// of a and b, rounded using the [DefaultRoundingMode]. The result is the same
+// as calling [Decimal.Add] on each pair of elements, but is faster when every
+// element is finite. dst may be the same slice as a or b.
+//
+// AddSlices panics if a and b have different lengths or dst is shorter than a.
+func AddSlices(dst, a, b []Decimal) {
+	AddSlicesWithMode(dst, a, b, DefaultRoundingMode)
+}
+
+// AddSlicesWithMode sets each element of dst to the sum of the corresponding
+// elements of a and b, rounded using the provided rounding mode. The result is
+// the same as calling [Decimal.AddWithMode] on each pair of elements, but is
+// faster when every element is finite. dst may be the same slice as a or b.
+//
+// AddSlicesWithMode panics if a and b have different lengths or dst is shorter
+// than a.
+func AddSlicesWithMode(dst, a, b []Decimal, mode RoundingMode) {
+	if len(a) != len(b) {
+		panic("decimal128.AddSlices: slices have different lengths")
+	}
+
+	if len(dst) < len(a) {
+		panic("decimal128.AddSlices: dst is too short")
+	}
+
+	dst = dst[:len(a)]
+	b = b[:len(a)]
+
+	if !allFinite(a) || !allFinite(b) {
+		for i := range a {
+			dst[i] = a[i].AddWithMode(b[i], mode)
+		}
+
+		return
+	}
+
+	for i := range a {
+		dSig, dExp := a[i].decompose()
+		oSig, oExp := b[i].decompose()
+
+		// Two coefficients that each fit in 64 bits sum to less than the
+		// maximum coefficient, so values with matching exponents are added
+		// exactly without needing to be rounded.
+		if dExp != oExp || dSig[1]|oSig[1] != 0 || dSig[0] == 0 || oSig[0] == 0 {
+			dst[i] = a[i].add(b[i], mode, false)
+			continue
+		}
+
+		dNeg := a[i].Signbit()
+		oNeg := b[i].Signbit()
+
+		if dNeg == oNeg {
+			sig0, sig1 := bits.Add64(dSig[0], oSig[0], 0)
+			dst[i] = compose(dNeg, uint128{sig0, sig1}, dExp)
+		} else if dSig[0] > oSig[0] {
+			dst[i] = compose(dNeg, uint128{dSig[0] - oSig[0], 0}, dExp)
+		} else if dSig[0] < oSig[0] {
+			dst[i] = compose(oNeg, uint128{oSig[0] - dSig[0], 0}, dExp)
+		} else {
+			dst[i] = zero(mode == ToNegativeInf)
+		}
+	}
+}
+
+// DotProduct returns the sum of the products of the corresponding elements of
+// a and b, rounded using the [DefaultRoundingMode].
+//
+// DotProduct panics if a and b have different lengths.
+func DotProduct(a, b []Decimal) Decimal {
+	return DotProductWithMode(a, b, DefaultRoundingMode)
+}
+
+// DotProductWithMode returns the sum of the products of the corresponding
+// elements of a and b, rounded using the provided rounding mode. Each product
+// is rounded as by [Decimal.MulWithMode], and the products are then summed as
+// by an [Accumulator], rounding only once. When every element is finite and
+// has a small coefficient, and the products all share an exponent, the
+// products are exact and are summed without the overhead of an Accumulator.
+//
+// DotProductWithMode panics if a and b have different lengths.
+func DotProductWithMode(a, b []Decimal, mode RoundingMode) Decimal {
+	if len(a) != len(b) {
+		panic("decimal128.DotProduct: slices have different lengths")
+	}
+
+	b = b[:len(a)]
+
+	var pos, neg uint192
+	var anyPos, anyNeg bool
+	exp := int16(-1)
+
+	for i := range a {
+		if a[i].isSpecial() || b[i].isSpecial() {
+			return dotProduct(a, b, mode)
+		}
+
+		dSig, dExp := a[i].decompose()
+		oSig, oExp := b[i].decompose()
+
+		if dSig[1]|oSig[1] != 0 {
+			return dotProduct(a, b, mode)
+		}
+
+		pNeg := a[i].Signbit() != b[i].Signbit()
+		if pNeg {
+			anyNeg = true
+		} else {
+			anyPos = true
+		}
+
+		sig1, sig0 := bits.Mul64(dSig[0], oSig[0])
+		if sig0|sig1 == 0 {
+			continue
+		}
+
+		// Only products of less than 10^34 are certain to be exact.
+		if sig1 >= 0x0001_ed09_bead_87c0 {
+			return dotProduct(a, b, mode)
+		}
+
+		pExp := (dExp - exponentBias) + (oExp - exponentBias) + exponentBias
+		if pExp != exp {
+			if exp != -1 || pExp < minBiasedExponent || pExp > maxBiasedExponent {
+				return dotProduct(a, b, mode)
+			}
+
+			exp = pExp
+		}
+
+		if pNeg {
+			neg = neg.add128(uint128{sig0, sig1})
+		} else {
+			pos = pos.add128(uint128{sig0, sig1})
+		}
+	}
+
+	return sumParts(pos, neg, exp, anyPos, anyNeg, mode)
+}
+
+// MulScalar sets each element of dst to the product of the corresponding
+// element of a and s, rounded using the [DefaultRoundingMode]. The result is
+// the same as calling [Decimal.Mul] on each element, but is faster when every
+// element is finite. dst may be the same slice as a.
+//
+// MulScalar panics if dst is shorter than a.
+func MulScalar(dst, a []Decimal, s Decimal) {
+	MulScalarWithMode(dst, a, s, DefaultRoundingMode)
+}
+
+// MulScalarWithMode sets each element of dst to the product of the
+// corresponding element of a and s, rounded using the provided rounding mode.
+// The result is the same as calling [Decimal.MulWithMode] on each element, but
+// is faster when every element is finite. dst may be the same slice as a.
+//
+// MulScalarWithMode panics if dst is shorter than a.
+func MulScalarWithMode(dst, a []Decimal, s Decimal, mode RoundingMode) {
+	if len(dst) < len(a) {
+		panic("decimal128.MulScalar: dst is too short")
+	}
+
+	dst = dst[:len(a)]
+
+	if s.isSpecial() || !allFinite(a) {
+		for i := range a {
+			dst[i] = a[i].MulWithMode(s, mode)
+		}
+
+		return
+	}
+
+	oSig, oExp := s.decompose()
+	oNeg := s.Signbit()
+
+	if oSig[1] != 0 {
+		for i := range a {
+			dst[i] = a[i].mul(s, mode)
+		}
+
+		return
+	}
+
+	for i := range a {
+		dSig, dExp := a[i].decompose()
+
+		if dSig[1] != 0 {
+			dst[i] = a[i].mul(s, mode)
+			continue
+		}
+
+		sig1, sig0 := bits.Mul64(dSig[0], oSig[0])
+		exp := (dExp - exponentBias) + (oExp - exponentBias) + exponentBias
+		neg := a[i].Signbit() != oNeg
+
+		// Products of less than 10^34 with an exponent in range do not need
+		// to be rounded.
+		if sig0|sig1 == 0 {
+			dst[i] = zero(neg)
+		} else if sig1 < 0x0001_ed09_bead_87c0 && exp >= minBiasedExponent && exp <= maxBiasedExponent {
+			dst[i] = compose(neg, uint128{sig0, sig1}, exp)
+		} else {
+			dst[i] = a[i].mul(s, mode)
+		}
+	}
+}
+
+// SumSlice returns the sum of the elements of a, rounded using the
+// [DefaultRoundingMode].
+func SumSlice(a []Decimal) Decimal {
+	return SumSliceWithMode(a, DefaultRoundingMode)
+}
+
+// SumSliceWithMode returns the sum of the elements of a, rounded using the
+// provided rounding mode. The result is the same as adding each element to an
+// [Accumulator], rounding only once. When every element is finite, has a small
+// coefficient, and shares the same exponent, the elements are summed without
+// the overhead of an Accumulator.
+func SumSliceWithMode(a []Decimal, mode RoundingMode) Decimal {
+	var pos, neg uint192
+	var anyPos, anyNeg bool
+	exp := int16(-1)
+
+	for _, d := range a {
+		if d.isSpecial() {
+			return sumSlice(a, mode)
+		}
+
+		sig, dExp := d.decompose()
+
+		if sig[1] != 0 {
+			return sumSlice(a, mode)
+		}
+
+		dNeg := d.Signbit()
+		if dNeg {
+			anyNeg = true
+		} else {
+			anyPos = true
+		}
+
+		if sig[0] == 0 {
+			continue
+		}
+
+		if dExp != exp {
+			if exp != -1 {
+				return sumSlice(a, mode)
+			}
+
+			exp = dExp
+		}
+
+		if dNeg {
+			neg = neg.add128(sig)
+		} else {
+			pos = pos.add128(sig)
+		}
+	}
+
+	return sumParts(pos, neg, exp, anyPos, anyNeg, mode)
+}
+
+func allFinite(a []Decimal) bool {
+	for _, d := range a {
+		if d.isSpecial() {
+			return false
+		}
+	}
+
+	return true
+}
+
+func dotProduct(a, b []Decimal, mode RoundingMode) Decimal {
+	var acc Accumulator
+	for i := range a {
+		acc.Add(a[i].MulWithMode(b[i], mode))
+	}
+
+	res, _ := acc.ResultWithMode(mode)
+	return res
+}
+
+func sumParts(pos, neg uint192, exp int16, anyPos, anyNeg bool, mode RoundingMode) Decimal {
+	sig, brw := pos.sub(neg)
+	resNeg := false
+
+	if brw != 0 {
+		sig = sig.twos()
+		resNeg = true
+	}
+
+	// Match the sign that an Accumulator gives to a zero sum.
+	if sig == (uint192{}) {
+		return zero(anyNeg && (!anyPos || mode == ToNegativeInf))
+	}
+
+	sig128, exp := mode.reduce192(resNeg, sig, exp, 0)
+
+	if exp > maxBiasedExponent {
+		return inf(resNeg)
+	}
+
+	return compose(resNeg, sig128, exp)
+}
+
+func sumSlice(a []Decimal, mode RoundingMode) Decimal {
+	var acc Accumulator
+	for _, d := range a {
+		acc.Add(d)
+	}
+
+	res, _ := acc.ResultWithMode(mode)
+	return res
+}
@@ -0,0 +1,338 @@
+package decimal128
+
+import (
+	"math/big"
+	"math/rand/v2"
+	"testing"
+)
+
+func batchValues(rnd *rand.Rand, n int, sameExp bool) []Decimal {
+	res := make([]Decimal, n)
+
+	for i := range res {
+		neg := rnd.IntN(2) == 0
+		exp := int16(exponentBias - 2)
+		if !sameExp {
+			exp = int16(exponentBias - 20 + rnd.IntN(40))
+		}
+
+		switch rnd.IntN(20) {
+		case 0:
+			res[i] = zero(neg)
+		case 1:
+			if sameExp {
+				res[i] = compose(neg, uint128{rnd.Uint64N(1_000_000_000), 0}, exp)
+			} else {
+				res[i] = inf(neg)
+			}
+		case 2:
+			if sameExp {
+				res[i] = compose(neg, uint128{rnd.Uint64N(1_000_000_000), 0}, exp)
+			} else {
+				res[i] = nan(payloadOpAdd, 0, 0)
+			}
+		case 3, 4:
+			res[i] = compose(neg, uint128{rnd.Uint64(), rnd.Uint64N(0x0001_ed09_bead_87c0)}, exp)
+		case 5, 6:
+			res[i] = compose(neg, uint128{rnd.Uint64(), 0}, exp)
+		default:
+			res[i] = compose(neg, uint128{rnd.Uint64N(1_000_000_000), 0}, exp)
+		}
+	}
+
+	return res
+}
+
+// batchCancelValues returns finite values spread over a wide range of
+// exponents, some of which cancel out values earlier in the slice.
+func batchCancelValues(rnd *rand.Rand, n int) []Decimal {
+	res := make([]Decimal, n)
+
+	for i := range res {
+		sig := uint128{rnd.Uint64(), rnd.Uint64N(0x0001_ed09_bead_87c0)}
+		if rnd.IntN(2) == 0 {
+			sig = uint128{rnd.Uint64N(1_000_000), 0}
+		}
+
+		res[i] = compose(rnd.IntN(2) == 0, sig, int16(exponentBias-1000+rnd.IntN(2000)))
+
+		if i > 0 && rnd.IntN(3) == 0 {
+			res[i] = res[rnd.IntN(i)].Neg()
+		}
+	}
+
+	return res
+}
+
+// batchRound returns the sum of values correctly rounded using mode.
+func batchRound(t *testing.T, values []Decimal, mode RoundingMode) Decimal {
+	t.Helper()
+
+	sum := new(big.Rat)
+	var anyPos, anyNeg bool
+
+	for _, d := range values {
+		sum.Add(sum, oracleRat(d))
+
+		if d.Signbit() {
+			anyNeg = true
+		} else {
+			anyPos = true
+		}
+	}
+
+	if sum.Sign() == 0 {
+		return zero(anyNeg && (!anyPos || mode == ToNegativeInf))
+	}
+
+	exact := func(r *big.Rat) bool {
+		return r.Cmp(sum) == 0
+	}
+
+	res, ok := oracleRound(new(big.Float).SetPrec(8192).SetRat(sum), 8192, exact, mode)
+	if !ok {
+		t.Fatalf("oracleRound(%v) undecided", values)
+	}
+
+	return res
+}
+
+func batchEqual(x, y Decimal) bool {
+	if x.IsNaN() && y.IsNaN() {
+		return resultEqual(x, y)
+	}
+
+	return x == y
+}
+
+func TestAddSlices(t *testing.T) {
+	t.Parallel()
+
+	rnd := rand.New(rand.NewPCG(1, 2))
+
+	for i := range 200 {
+		n := rnd.IntN(50)
+		a := batchValues(rnd, n, i%2 == 0)
+		b := batchValues(rnd, n, i%2 == 0)
+		dst := make([]Decimal, n)
+
+		for _, mode := range roundingModes {
+			AddSlicesWithMode(dst, a, b, mode)
+
+			for j := range a {
+				if want := a[j].AddWithMode(b[j], mode); !batchEqual(dst[j], want) {
+					t.Errorf("AddSlicesWithMode(%v, %v, %v) = %v, want %v", a[j], b[j], mode, dst[j], want)
+				}
+			}
+		}
+	}
+
+	a := []Decimal{New(1, 0), New(2, 0)}
+	AddSlices(a, a, a)
+
+	if !a[0].Equal(New(2, 0)) || !a[1].Equal(New(4, 0)) {
+		t.Errorf("AddSlices(a, a, a) = %v, want [2 4]", a)
+	}
+}
+
+func TestBatchCancellation(t *testing.T) {
+	t.Parallel()
+
+	rnd := rand.New(rand.NewPCG(1, 2))
+
+	for range 200 {
+		a := batchCancelValues(rnd, 1+rnd.IntN(20))
+
+		for _, mode := range roundingModes {
+			if res, want := SumSliceWithMode(a, mode), batchRound(t, a, mode); !resultEqual(res, want) {
+				t.Errorf("SumSliceWithMode(%v, %v) = %v, want %v", a, mode, res, want)
+			}
+		}
+	}
+
+	for range 200 {
+		n := 1 + rnd.IntN(20)
+		a := batchCancelValues(rnd, n)
+		b := batchCancelValues(rnd, n)
+
+		for _, mode := range roundingModes {
+			prd := make([]Decimal, n)
+			for j := range a {
+				prd[j] = a[j].MulWithMode(b[j], mode)
+			}
+
+			if res, want := DotProductWithMode(a, b, mode), batchRound(t, prd, mode); !resultEqual(res, want) {
+				t.Errorf("DotProductWithMode(%v, %v, %v) = %v, want %v", a, b, mode, res, want)
+			}
+		}
+	}
+
+	a := []Decimal{MustParse("12345"), MustParse("1e60"), MustParse("-7e-60"), MustParse("-12345")}
+	want := MustParse("9.999999999999999999999999999999999e59")
+
+	if res := SumSliceWithMode(a, ToZero); res != want {
+		t.Errorf("SumSliceWithMode(%v, ToZero) = %v, want %v", a, res, want)
+	}
+}
+
+func TestDotProduct(t *testing.T) {
+	t.Parallel()
+
+	rnd := rand.New(rand.NewPCG(1, 2))
+
+	for i := range 400 {
+		n := rnd.IntN(50)
+		a := batchValues(rnd, n, i%2 == 0)
+		b := batchValues(rnd, n, i%2 == 0)
+
+		for _, mode := range roundingModes {
+			var acc Accumulator
+			for j := range a {
+				acc.Add(a[j].MulWithMode(b[j], mode))
+			}
+
+			want, _ := acc.ResultWithMode(mode)
+
+			if res := DotProductWithMode(a, b, mode); !batchEqual(res, want) {
+				t.Errorf("DotProductWithMode(%v, %v, %v) = %v, want %v", a, b, mode, res, want)
+			}
+		}
+	}
+
+	a := []Decimal{MustParse("1.5"), MustParse("2.5"), MustParse("-3")}
+	b := []Decimal{MustParse("2"), MustParse("4"), MustParse("1.25")}
+
+	if res := DotProduct(a, b); res != MustParse("9.25") {
+		t.Errorf("DotProduct(%v, %v) = %v, want 9.25", a, b, res)
+	}
+}
+
+func TestMulScalar(t *testing.T) {
+	t.Parallel()
+
+	rnd := rand.New(rand.NewPCG(1, 2))
+	scalars := batchValues(rnd, 40, false)
+
+	for i, s := range scalars {
+		a := batchValues(rnd, rnd.IntN(50), i%2 == 0)
+		dst := make([]Decimal, len(a)+1)
+
+		for _, mode := range roundingModes {
+			MulScalarWithMode(dst, a, s, mode)
+
+			for j := range a {
+				if want := a[j].MulWithMode(s, mode); !batchEqual(dst[j], want) {
+					t.Errorf("MulScalarWithMode(%v, %v, %v) = %v, want %v", a[j], s, mode, dst[j], want)
+				}
+			}
+
+			if dst[len(a)] != (Decimal{}) {
+				t.Errorf("MulScalarWithMode(%v, %v, %v) wrote past the end of a", a, s, mode)
+			}
+		}
+	}
+}
+
+func TestSliceLengths(t *testing.T) {
+	t.Parallel()
+
+	values := []struct {
+		name string
+		fn   func()
+	}{
+		{"AddSlices", func() { AddSlices(make([]Decimal, 2), make([]Decimal, 2), make([]Decimal, 1)) }},
+		{"AddSlices", func() { AddSlices(make([]Decimal, 1), make([]Decimal, 2), make([]Decimal, 2)) }},
+		{"DotProduct", func() { DotProduct(make([]Decimal, 2), make([]Decimal, 3)) }},
+		{"MulScalar", func() { MulScalar(make([]Decimal, 1), make([]Decimal, 2), New(1, 0)) }},
+	}
+
+	for _, val := range values {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s did not panic on mismatched slice lengths", val.name)
+				}
+			}()
+
+			val.fn()
+		}()
+	}
+}
+
+func TestSumSlice(t *testing.T) {
+	t.Parallel()
+
+	rnd := rand.New(rand.NewPCG(1, 2))
+
+	for i := range 400 {
+		a := batchValues(rnd, rnd.IntN(50), i%2 == 0)
+
+		for _, mode := range roundingModes {
+			var acc Accumulator
+			for _, d := range a {
+				acc.Add(d)
+			}
+
+			want, _ := acc.ResultWithMode(mode)
+
+			if res := SumSliceWithMode(a, mode); !batchEqual(res, want) {
+				t.Errorf("SumSliceWithMode(%v, %v) = %v, want %v", a, mode, res, want)
+			}
+		}
+	}
+
+	a := []Decimal{MustParse("0.10"), MustParse("0.20"), MustParse("-0.05")}
+
+	if res := SumSlice(a); res != MustParse("0.25") {
+		t.Errorf("SumSlice(%v) = %v, want 0.25", a, res)
+	}
+}
+
+func benchmarkBatchValues() ([]Decimal, []Decimal) {
+	rnd := rand.New(rand.NewPCG(1, 2))
+
+	a := make([]Decimal, 1024)
+	b := make([]Decimal, 1024)
+
+	for i := range a {
+		a[i] = compose(rnd.IntN(2) == 0, uint128{rnd.Uint64N(1_000_000_000_000), 0}, exponentBias-4)
+		b[i] = compose(rnd.IntN(2) == 0, uint128{rnd.Uint64N(1_000_000_000_000), 0}, exponentBias-4)
+	}
+
+	return a, b
+}
+
+func BenchmarkAddSlices(b *testing.B) {
+	x, y := benchmarkBatchValues()
+	dst := make([]Decimal, len(x))
+
+	for b.Loop() {
+		AddSlices(dst, x, y)
+	}
+}
+
+func BenchmarkDotProduct(b *testing.B) {
+	x, y := benchmarkBatchValues()
+
+	for b.Loop() {
+		DotProduct(x, y)
+	}
+}
+
+func BenchmarkMulScalar(b *testing.B) {
+	x, _ := benchmarkBatchValues()
+	dst := make([]Decimal, len(x))
+	s := MustParse("1.0125")
+
+	for b.Loop() {
+		MulScalar(dst, x, s)
+	}
+}
+
+func BenchmarkSumSlice(b *testing.B) {
+	x, _ := benchmarkBatchValues()
+
+	for b.Loop() {
+		SumSlice(x)
+	}
+}
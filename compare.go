@@ -24,14 +24,15 @@ func Compare(d, o Decimal) int {
 	return int(d.Cmp(o))
 }
 
-// Max returns the larger of d or o. If either value is NaN the result is NaN.
+// Max returns the larger of d or o. If either value is NaN the result is NaN,
+// with signaling NaNs converted to quiet NaNs.
 func Max(d, o Decimal) Decimal {
 	if d.IsNaN() {
-		return d
+		return d.quiet()
 	}
 
 	if o.IsNaN() {
-		return o
+		return o.quiet()
 	}
 
 	if d.IsZero() && o.IsZero() {
@@ -49,16 +50,103 @@ func Max(d, o Decimal) Decimal {
 	return d
 }
 
-// Min returns the smaller of d or o. If either value is NaN the result is NaN.
-func Min(d, o Decimal) Decimal {
+// Maximum returns the larger of d or o, following the IEEE 754 maximum
+// operation. If either value is NaN the result is NaN, with signaling NaNs
+// converted to quiet NaNs. Negative zero is considered to be less than
+// positive zero.
+//
+// Unlike [Max], when d and o are equal but stored with different exponents,
+// Maximum consistently returns whichever is ordered last by [TotalOrder].
+func Maximum(d, o Decimal) Decimal {
+	if d.IsNaN() {
+		return d.quiet()
+	}
+
+	if o.IsNaN() {
+		return o.quiet()
+	}
+
+	if TotalOrder(d, o) < 0 {
+		return o
+	}
+
+	return d
+}
+
+// MaximumMag returns whichever of d or o has the larger absolute value,
+// following the IEEE 754 maximumMagnitude operation. If the absolute values
+// are equal the result is the same as [Maximum]. If either value is NaN the
+// result is NaN, with signaling NaNs converted to quiet NaNs.
+func MaximumMag(d, o Decimal) Decimal {
 	if d.IsNaN() {
+		return d.quiet()
+	}
+
+	if o.IsNaN() {
+		return o.quiet()
+	}
+
+	switch d.CmpAbs(o) {
+	case cmpGreater:
 		return d
+	case cmpLess:
+		return o
+	}
+
+	return Maximum(d, o)
+}
+
+// MaximumMagNumber returns whichever of d or o has the larger absolute value,
+// following the IEEE 754 maximumMagnitudeNumber operation. It is the same as
+// [MaximumMag], except that if only one of the values is NaN the other value
+// is returned. If both values are NaN the result is NaN.
+func MaximumMagNumber(d, o Decimal) Decimal {
+	if d.IsNaN() {
+		if o.IsNaN() {
+			return d.quiet()
+		}
+
+		return o
 	}
 
 	if o.IsNaN() {
+		return d
+	}
+
+	return MaximumMag(d, o)
+}
+
+// MaximumNumber returns the larger of d or o, following the IEEE 754
+// maximumNumber operation. It is the same as [Maximum], except that if only
+// one of the values is NaN the other value is returned. If both values are
+// NaN the result is NaN.
+func MaximumNumber(d, o Decimal) Decimal {
+	if d.IsNaN() {
+		if o.IsNaN() {
+			return d.quiet()
+		}
+
 		return o
 	}
 
+	if o.IsNaN() {
+		return d
+	}
+
+	return Maximum(d, o)
+}
+
+// Min returns the smaller of d or o. If either value is NaN the result is NaN,
+// with signaling NaNs converted to quiet NaNs.
+func Min(d, o Decimal) Decimal {
+	if d.IsNaN() {
+		return d.quiet()
+	}
+
+	if o.IsNaN() {
+		return o.quiet()
+	}
+
 	if d.IsZero() && o.IsZero() {
 		if d.Signbit() || o.Signbit() {
 			return zero(true)
@@ -74,6 +162,182 @@ func Min(d, o Decimal) Decimal {
 	return d
 }
 
+// Minimum returns the smaller of d or o, following the IEEE 754 minimum
+// operation. If either value is NaN the result is NaN, with signaling NaNs
+// converted to quiet NaNs. Negative zero is considered to be less than
+// positive zero.
+//
+// Unlike [Min], when d and o are equal but stored with different exponents,
+// Minimum consistently returns whichever is ordered first by [TotalOrder].
+func Minimum(d, o Decimal) Decimal {
+	if d.IsNaN() {
+		return d.quiet()
+	}
+
+	if o.IsNaN() {
+		return o.quiet()
+	}
+
+	if TotalOrder(d, o) > 0 {
+		return o
+	}
+
+	return d
+}
+
+// MinimumMag returns whichever of d or o has the smaller absolute value,
+// following the IEEE 754 minimumMagnitude operation. If the absolute values
+// are equal the result is the same as [Minimum]. If either value is NaN the
+// result is NaN, with signaling NaNs converted to quiet NaNs.
+func MinimumMag(d, o Decimal) Decimal {
+	if d.IsNaN() {
+		return d.quiet()
+	}
+
+	if o.IsNaN() {
+		return o.quiet()
+	}
+
+	switch d.CmpAbs(o) {
+	case cmpLess:
+		return d
+	case cmpGreater:
+		return o
+	}
+
+	return Minimum(d, o)
+}
+
+// MinimumMagNumber returns whichever of d or o has the smaller absolute value,
+// following the IEEE 754 minimumMagnitudeNumber operation. It is the same as
+// [MinimumMag], except that if only one of the values is NaN the other value
+// is returned. If both values are NaN the result is NaN.
+func MinimumMagNumber(d, o Decimal) Decimal {
+	if d.IsNaN() {
+		if o.IsNaN() {
+			return d.quiet()
+		}
+
+		return o
+	}
+
+	if o.IsNaN() {
+		return d
+	}
+
+	return MinimumMag(d, o)
+}
+
+// MinimumNumber returns the smaller of d or o, following the IEEE 754
+// minimumNumber operation. It is the same as [Minimum], except that if only
+// one of the values is NaN the other value is returned. If both values are
+// NaN the result is NaN.
+func MinimumNumber(d, o Decimal) Decimal {
+	if d.IsNaN() {
+		if o.IsNaN() {
+			return d.quiet()
+		}
+
+		return o
+	}
+
+	if o.IsNaN() {
+		return d
+	}
+
+	return Minimum(d, o)
+}
+
+// TotalOrder returns:
+//
+//	-1 if d is ordered before o
+//	 0 if d and o have the same representation
+//	+1 if d is ordered after o
+//
+// TotalOrder implements the IEEE 754 totalOrder predicate, which is true
+// exactly when TotalOrder(d, o) <= 0. Unlike [Compare], it places every
+// Decimal in a single deterministic order: negative NaNs, -Inf, negative
+// finite values, -0, +0, positive finite values, +Inf, then positive NaNs.
+// Equal finite values stored with different exponents are ordered by their
+// exponent, with positive values having smaller exponents ordered first and
+// negative values having smaller exponents ordered last. NaNs of the same sign
+// are ordered with signaling NaNs closer to zero than quiet NaNs, followed by
+// their payloads.
+func TotalOrder(d, o Decimal) int {
+	neg := d.Signbit()
+
+	if neg != o.Signbit() {
+		if neg {
+			return -1
+		}
+
+		return 1
+	}
+
+	res := totalOrderMag(d, o)
+
+	if neg {
+		return res * -1
+	}
+
+	return res
+}
+
+// TotalOrderMag returns the same result as [TotalOrder] would for the absolute
+// values of d and o, implementing the IEEE 754 totalOrderMag predicate.
+func TotalOrderMag(d, o Decimal) int {
+	return totalOrderMag(d, o)
+}
+
+func totalOrderMag(d, o Decimal) int {
+	if d.IsNaN() {
+		if !o.IsNaN() {
+			return 1
+		}
+
+		dSignal := d.IsSignalingNaN()
+
+		if dSignal != o.IsSignalingNaN() {
+			if dSignal {
+				return -1
+			}
+
+			return 1
+		}
+
+		// The payload of a NaN is stored in the trailing significand bits.
+		dPayload := uint128{d.lo, d.hi & 0x0000_3fff_ffff_ffff}
+		oPayload := uint128{o.lo, o.hi & 0x0000_3fff_ffff_ffff}
+
+		return dPayload.cmp(oPayload)
+	}
+
+	if o.IsNaN() {
+		return -1
+	}
+
+	if res := d.CmpAbs(o); res != cmpEqual {
+		return int(res)
+	}
+
+	if d.isInf() {
+		return 0
+	}
+
+	_, dExp := d.decompose()
+	_, oExp := o.decompose()
+
+	if dExp < oExp {
+		return -1
+	}
+
+	if dExp > oExp {
+		return 1
+	}
+
+	return 0
+}
+
 // CmpResult represents the result from comparing two Decimals. When the values
 // being compared aren't NaNs, the integer value of the CmpResult will be:
 //
@@ -1039,6 +1303,25 @@ func (d Decimal) IsZero() bool {
 	}
 }
 
+// SameQuantum reports whether d and o have the same exponent, following the
+// IEEE 754 sameQuantum operation. Two NaN values or two infinite values are
+// always considered to have the same quantum, while a NaN or infinite value
+// never has the same quantum as a finite value.
+func (d Decimal) SameQuantum(o Decimal) bool {
+	if d.isSpecial() || o.isSpecial() {
+		if d.IsNaN() || o.IsNaN() {
+			return d.IsNaN() && o.IsNaN()
+		}
+
+		return d.isInf() && o.isInf()
+	}
+
+	_, dExp := d.decompose()
+	_, oExp := o.decompose()
+
+	return dExp == oExp
+}
+
 func (d Decimal) isOne() bool {
 	if d.isSpecial() {
 		return false
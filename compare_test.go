@@ -264,6 +264,40 @@ func TestDecimalIsZero(t *testing.T) {
 	}
 }
 
+func TestDecimalSameQuantum(t *testing.T) {
+	t.Parallel()
+
+	initDecimalValues()
+
+	quanta := []testDec{
+		{regularForm, false, uint128{1, 0}, minBiasedExponent},
+		{regularForm, true, uint128{10, 0}, exponentBias - 19},
+		{regularForm, false, uint128{0, 0}, exponentBias},
+		{regularForm, true, uint128{5, 0}, exponentBias + 19},
+		{regularForm, false, uint128{1, 0}, maxBiasedExponent},
+		{infForm, false, uint128{}, 0},
+		{infForm, true, uint128{}, 0},
+		{nanForm, false, uint128{}, 0},
+	}
+
+	for _, val := range decimalValues {
+		decval := val.Decimal()
+
+		for _, qnt := range quanta {
+			same := decval.SameQuantum(qnt.Decimal())
+
+			res := val.form == qnt.form
+			if res && val.form == regularForm {
+				res = val.exp == qnt.exp
+			}
+
+			if same != res {
+				t.Errorf("%v.SameQuantum(%v) = %t, want %t", val, qnt, same, res)
+			}
+		}
+	}
+}
+
 func TestMax(t *testing.T) {
 	t.Parallel()
 
@@ -283,6 +317,82 @@ func TestMax(t *testing.T) {
 	}
 }
 
+func TestMaximum(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var lhs Decimal
+	var rhs Decimal
+	var res Decimal
+
+	for r.scan("maximum(%v, %v) = %v\n", &lhs, &rhs, &res) {
+		max := Maximum(lhs, rhs)
+
+		if !resultEqual(max, res) || !max.SameQuantum(res) {
+			t.Errorf("Maximum(%v, %v) = %v, want %v", lhs, rhs, max, res)
+		}
+	}
+}
+
+func TestMaximumMag(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var lhs Decimal
+	var rhs Decimal
+	var res Decimal
+
+	for r.scan("maximummag(%v, %v) = %v\n", &lhs, &rhs, &res) {
+		max := MaximumMag(lhs, rhs)
+
+		if !resultEqual(max, res) || !max.SameQuantum(res) {
+			t.Errorf("MaximumMag(%v, %v) = %v, want %v", lhs, rhs, max, res)
+		}
+	}
+}
+
+func TestMaximumMagNumber(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var lhs Decimal
+	var rhs Decimal
+	var res Decimal
+
+	for r.scan("maximummagnumber(%v, %v) = %v\n", &lhs, &rhs, &res) {
+		max := MaximumMagNumber(lhs, rhs)
+
+		if !resultEqual(max, res) || !max.SameQuantum(res) {
+			t.Errorf("MaximumMagNumber(%v, %v) = %v, want %v", lhs, rhs, max, res)
+		}
+	}
+}
+
+func TestMaximumNumber(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var lhs Decimal
+	var rhs Decimal
+	var res Decimal
+
+	for r.scan("maximumnumber(%v, %v) = %v\n", &lhs, &rhs, &res) {
+		max := MaximumNumber(lhs, rhs)
+
+		if !resultEqual(max, res) || !max.SameQuantum(res) {
+			t.Errorf("MaximumNumber(%v, %v) = %v, want %v", lhs, rhs, max, res)
+		}
+	}
+}
+
 func TestMin(t *testing.T) {
 	t.Parallel()
 
@@ -302,6 +412,141 @@ func TestMin(t *testing.T) {
 	}
 }
 
+func TestMinimum(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var lhs Decimal
+	var rhs Decimal
+	var res Decimal
+
+	for r.scan("minimum(%v, %v) = %v\n", &lhs, &rhs, &res) {
+		min := Minimum(lhs, rhs)
+
+		if !resultEqual(min, res) || !min.SameQuantum(res) {
+			t.Errorf("Minimum(%v, %v) = %v, want %v", lhs, rhs, min, res)
+		}
+	}
+}
+
+func TestMinimumMag(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var lhs Decimal
+	var rhs Decimal
+	var res Decimal
+
+	for r.scan("minimummag(%v, %v) = %v\n", &lhs, &rhs, &res) {
+		min := MinimumMag(lhs, rhs)
+
+		if !resultEqual(min, res) || !min.SameQuantum(res) {
+			t.Errorf("MinimumMag(%v, %v) = %v, want %v", lhs, rhs, min, res)
+		}
+	}
+}
+
+func TestMinimumMagNumber(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var lhs Decimal
+	var rhs Decimal
+	var res Decimal
+
+	for r.scan("minimummagnumber(%v, %v) = %v\n", &lhs, &rhs, &res) {
+		min := MinimumMagNumber(lhs, rhs)
+
+		if !resultEqual(min, res) || !min.SameQuantum(res) {
+			t.Errorf("MinimumMagNumber(%v, %v) = %v, want %v", lhs, rhs, min, res)
+		}
+	}
+}
+
+func TestMinimumNumber(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var lhs Decimal
+	var rhs Decimal
+	var res Decimal
+
+	for r.scan("minimumnumber(%v, %v) = %v\n", &lhs, &rhs, &res) {
+		min := MinimumNumber(lhs, rhs)
+
+		if !resultEqual(min, res) || !min.SameQuantum(res) {
+			t.Errorf("MinimumNumber(%v, %v) = %v, want %v", lhs, rhs, min, res)
+		}
+	}
+}
+
+func TestTotalOrder(t *testing.T) {
+	t.Parallel()
+
+	values := []Decimal{
+		NaN().Neg(),
+		SignalingNaN(2).Neg(),
+		SignalingNaN(1).Neg(),
+		Inf(-1),
+		compose(true, uint128{1234567890123456789, 0}, maxBiasedExponent),
+		compose(true, uint128{1, 0}, exponentBias+1),
+		compose(true, uint128{10, 0}, exponentBias),
+		compose(true, uint128{1, 0}, exponentBias),
+		compose(true, uint128{10, 0}, exponentBias-1),
+		compose(true, uint128{100, 0}, exponentBias-2),
+		compose(true, uint128{1, 0}, minBiasedExponent),
+		compose(true, uint128{}, maxBiasedExponent),
+		compose(true, uint128{}, exponentBias),
+		compose(true, uint128{}, minBiasedExponent),
+		compose(false, uint128{}, minBiasedExponent),
+		compose(false, uint128{}, exponentBias),
+		compose(false, uint128{}, maxBiasedExponent),
+		compose(false, uint128{1, 0}, minBiasedExponent),
+		compose(false, uint128{100, 0}, exponentBias-2),
+		compose(false, uint128{10, 0}, exponentBias-1),
+		compose(false, uint128{1, 0}, exponentBias),
+		compose(false, uint128{10, 0}, exponentBias),
+		compose(false, uint128{1, 0}, exponentBias+1),
+		compose(false, uint128{1234567890123456789, 0}, maxBiasedExponent),
+		Inf(1),
+		SignalingNaN(1),
+		SignalingNaN(2),
+		NaN(),
+	}
+
+	for i, lhs := range values {
+		for j, rhs := range values {
+			res := TotalOrder(lhs, rhs)
+
+			var want int
+			if i < j {
+				want = -1
+			} else if i > j {
+				want = 1
+			}
+
+			if res != want {
+				t.Errorf("TotalOrder(%v, %v) = %d, want %d", lhs, rhs, res, want)
+			}
+
+			res = TotalOrderMag(lhs, rhs)
+			want = TotalOrder(Abs(lhs), Abs(rhs))
+
+			if res != want {
+				t.Errorf("TotalOrderMag(%v, %v) = %d, want %d", lhs, rhs, res, want)
+			}
+		}
+	}
+}
+
 func BenchmarkDecimalCmp(b *testing.B) {
 	initDecimalValues()
 
@@ -0,0 +1,308 @@
+package decimal128
+
+import (
+	"errors"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Condition is a set of the exceptional conditions defined by IEEE 754 that
+// can be signalled by an operation performed through a [Context]. A Condition
+// implements the error interface so that trapped conditions can be returned
+// from operations and compared using [errors.Is].
+type Condition uint8
+
+const (
+	Inexact          Condition = 1 << iota // result was rounded
+	Underflow                              // result was tiny and rounded
+	Overflow                               // result was too large to represent
+	DivisionByZero                         // exact infinite result from finite operands
+	InvalidOperation                       // result has no meaningful value
+)
+
+var conditionNames = [...]string{
+	"Inexact",
+	"Underflow",
+	"Overflow",
+	"DivisionByZero",
+	"InvalidOperation",
+}
+
+// Error implements the error interface.
+func (c Condition) Error() string {
+	return "decimal128: " + c.String()
+}
+
+// Is reports whether c contains any of the conditions in target. This allows
+// an error returned by a [Context] operation to be compared to a single
+// condition using [errors.Is], even if multiple conditions were trapped.
+func (c Condition) Is(target error) bool {
+	tc, ok := target.(Condition)
+	return ok && c&tc != 0
+}
+
+// String returns a string representation of the set of conditions.
+func (c Condition) String() string {
+	if c == 0 {
+		return "Condition(0)"
+	}
+
+	var b strings.Builder
+	for i, name := range conditionNames {
+		if c&(1<<i) == 0 {
+			continue
+		}
+
+		if b.Len() > 0 {
+			b.WriteByte('|')
+		}
+
+		b.WriteString(name)
+	}
+
+	return b.String()
+}
+
+// Context performs arithmetic using a specific rounding mode, recording any
+// exceptional conditions that occur in its Flags. Flags are sticky: once a
+// condition has been raised it remains set until the caller clears it. This
+// allows a sequence of operations to be checked for exactness (or for any
+// other condition) after it has completed.
+//
+// If a raised condition is also present in Traps, the operation returns the
+// trapped conditions as an error alongside its result.
+//
+// Unlike the [DefaultRoundingMode], a Context only affects the operations
+// performed through it, so separate goroutines can each use their own Context
+// with a different rounding mode. The zero value for Context rounds using
+// [ToNearestEven] and has no traps enabled. A single Context must not be used
+// concurrently by multiple goroutines.
+type Context struct {
+	Mode  RoundingMode // rounding mode used by operations
+	Traps Condition    // conditions that cause an error to be returned
+	Flags Condition    // conditions that have been raised
+}
+
+// Add adds x and y and returns the result.
+func (c *Context) Add(x, y Decimal) (Decimal, error) {
+	res := x.AddWithMode(y, c.Mode)
+
+	if x.isSpecial() || y.isSpecial() {
+		return res, c.special(res, x, y)
+	}
+
+	return res, c.rounded(res, !x.AddWithMode(y, ToZero).Equal(x.AddWithMode(y, AwayFromZero)))
+}
+
+// Exp returns e**x, the base-e exponential of x.
+func (c *Context) Exp(x Decimal) (Decimal, error) {
+	res := ExpWithMode(x, c.Mode)
+
+	if x.isSpecial() {
+		return res, c.special(res, x)
+	}
+
+	if x.IsZero() {
+		return res, nil
+	}
+
+	return res, c.rounded(res, true)
+}
+
+// Log returns the natural logarithm of x.
+func (c *Context) Log(x Decimal) (Decimal, error) {
+	res := LogWithMode(x, c.Mode)
+
+	if x.isSpecial() {
+		return res, c.special(res, x)
+	}
+
+	if x.IsZero() {
+		return res, c.raise(DivisionByZero)
+	}
+
+	if x.Signbit() {
+		return res, c.raise(InvalidOperation)
+	}
+
+	if x.isOne() {
+		return res, nil
+	}
+
+	return res, c.rounded(res, true)
+}
+
+// Mul multiplies x and y and returns the result.
+func (c *Context) Mul(x, y Decimal) (Decimal, error) {
+	res := x.MulWithMode(y, c.Mode)
+
+	if x.isSpecial() || y.isSpecial() {
+		return res, c.special(res, x, y)
+	}
+
+	if x.IsZero() || y.IsZero() {
+		return res, nil
+	}
+
+	return res, c.rounded(res, res.IsZero() || !x.MulWithMode(y, ToZero).Equal(x.MulWithMode(y, AwayFromZero)))
+}
+
+// Parse parses a Decimal value from the string provided, accepting the same
+// syntax as [Parse]. Errors from parsing are returned unchanged, although a
+// value that is out of range also raises Overflow.
+func (c *Context) Parse(s string) (Decimal, error) {
+	res, err := ParseWithMode(s, c.Mode)
+	if err != nil {
+		if errors.Is(err, strconv.ErrRange) {
+			c.raise(Overflow | Inexact)
+		}
+
+		return res, err
+	}
+
+	if res.isSpecial() {
+		return res, nil
+	}
+
+	lo, _ := ParseWithMode(s, ToZero)
+	hi, _ := ParseWithMode(s, AwayFromZero)
+
+	return res, c.rounded(res, !lo.Equal(hi) || res.IsZero() && hasNonZeroDigit(s))
+}
+
+// Quo divides x by y and returns the result.
+func (c *Context) Quo(x, y Decimal) (Decimal, error) {
+	res := x.QuoWithMode(y, c.Mode)
+
+	if x.isSpecial() || y.isSpecial() {
+		return res, c.special(res, x, y)
+	}
+
+	if y.IsZero() {
+		if x.IsZero() {
+			return res, c.raise(InvalidOperation)
+		}
+
+		return res, c.raise(DivisionByZero)
+	}
+
+	if x.IsZero() {
+		return res, nil
+	}
+
+	return res, c.rounded(res, res.IsZero() || !x.QuoWithMode(y, ToZero).Equal(x.QuoWithMode(y, AwayFromZero)))
+}
+
+// Sqrt returns the square root of x.
+func (c *Context) Sqrt(x Decimal) (Decimal, error) {
+	res := SqrtWithMode(x, c.Mode)
+
+	if x.isSpecial() {
+		return res, c.special(res, x)
+	}
+
+	if x.IsZero() {
+		return res, nil
+	}
+
+	if x.Signbit() {
+		return res, c.raise(InvalidOperation)
+	}
+
+	sqr := res.Rat(nil)
+	sqr.Mul(sqr, sqr)
+
+	return res, c.rounded(res, sqr.Cmp(x.Rat(new(big.Rat))) != 0)
+}
+
+// Sub subtracts y from x and returns the result.
+func (c *Context) Sub(x, y Decimal) (Decimal, error) {
+	res := x.SubWithMode(y, c.Mode)
+
+	if x.isSpecial() || y.isSpecial() {
+		return res, c.special(res, x, y)
+	}
+
+	return res, c.rounded(res, !x.SubWithMode(y, ToZero).Equal(x.SubWithMode(y, AwayFromZero)))
+}
+
+func (c *Context) raise(cond Condition) error {
+	c.Flags |= cond
+
+	if trapped := cond & c.Traps; trapped != 0 {
+		return trapped
+	}
+
+	return nil
+}
+
+func (c *Context) rounded(res Decimal, inexact bool) error {
+	if res.isInf() {
+		return c.raise(Overflow | Inexact)
+	}
+
+	if !inexact {
+		return nil
+	}
+
+	if res.isTiny() {
+		return c.raise(Underflow | Inexact)
+	}
+
+	return c.raise(Inexact)
+}
+
+func (c *Context) special(res Decimal, args ...Decimal) error {
+	for _, arg := range args {
+		if arg.IsSignalingNaN() {
+			return c.raise(InvalidOperation)
+		}
+	}
+
+	if !res.IsNaN() {
+		return nil
+	}
+
+	for _, arg := range args {
+		if arg.IsNaN() {
+			return nil
+		}
+	}
+
+	return c.raise(InvalidOperation)
+}
+
+func (d Decimal) isTiny() bool {
+	sig, exp := d.decompose()
+
+	if sig[0]|sig[1] == 0 {
+		return true
+	}
+
+	// The smallest normal value is 1e-6143, so the value is tiny if
+	// sig < 10**(-6143 - (exp - exponentBias)).
+	n := -6143 - (int(exp) - exponentBias)
+	if n <= 0 {
+		return false
+	}
+
+	if n >= len(uint128PowersOf10) {
+		return true
+	}
+
+	return sig.cmp(uint128PowersOf10[n]) < 0
+}
+
+func hasNonZeroDigit(s string) bool {
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c >= '1' && c <= '9':
+			return true
+		case c == 'E' || c == 'e':
+			return false
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,158 @@
+package decimal128
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestCondition(t *testing.T) {
+	t.Parallel()
+
+	if s := Condition(0).String(); s != "Condition(0)" {
+		t.Errorf("Condition(0).String() = %s, want Condition(0)", s)
+	}
+
+	if s := (Inexact | Underflow).String(); s != "Inexact|Underflow" {
+		t.Errorf("(Inexact|Underflow).String() = %s, want Inexact|Underflow", s)
+	}
+
+	if s := InvalidOperation.Error(); s != "decimal128: InvalidOperation" {
+		t.Errorf("InvalidOperation.Error() = %s, want decimal128: InvalidOperation", s)
+	}
+
+	var err error = Overflow | Inexact
+	if !errors.Is(err, Overflow) || !errors.Is(err, Inexact) {
+		t.Errorf("errors.Is(%v, Overflow|Inexact) = false, want true", err)
+	}
+
+	if errors.Is(err, DivisionByZero) {
+		t.Errorf("errors.Is(%v, DivisionByZero) = true, want false", err)
+	}
+}
+
+func TestContext(t *testing.T) {
+	t.Parallel()
+
+	tiny := MustParse("1e-6176")
+	huge := MustParse("9e6144")
+	three := FromInt64(3)
+
+	tests := []struct {
+		name string
+		op   func(*Context) (Decimal, error)
+		res  Decimal
+		cond Condition
+	}{
+		{"1 + 2", func(c *Context) (Decimal, error) { return c.Add(FromInt64(1), FromInt64(2)) }, three, 0},
+		{"1e35 + 1", func(c *Context) (Decimal, error) { return c.Add(New(1, 35), FromInt64(1)) }, New(1, 35), Inexact},
+		{"Inf + -Inf", func(c *Context) (Decimal, error) { return c.Add(Inf(1), Inf(-1)) }, NaN(), InvalidOperation},
+		{"NaN + 1", func(c *Context) (Decimal, error) { return c.Add(NaN(), FromInt64(1)) }, NaN(), 0},
+		{"sNaN + 1", func(c *Context) (Decimal, error) { return c.Add(SignalingNaN(0), FromInt64(1)) }, NaN(), InvalidOperation},
+		{"exp(sNaN)", func(c *Context) (Decimal, error) { return c.Exp(SignalingNaN(0)) }, NaN(), InvalidOperation},
+		{"huge + huge", func(c *Context) (Decimal, error) { return c.Add(huge, huge) }, Inf(1), Overflow | Inexact},
+		{"3 - 1", func(c *Context) (Decimal, error) { return c.Sub(three, FromInt64(1)) }, FromInt64(2), 0},
+		{"1 - 1e-40", func(c *Context) (Decimal, error) { return c.Sub(FromInt64(1), New(1, -40)) }, FromInt64(1), Inexact},
+		{"1.5 * 3", func(c *Context) (Decimal, error) { return c.Mul(New(15, -1), three) }, New(45, -1), 0},
+		{"huge * 10", func(c *Context) (Decimal, error) { return c.Mul(huge, FromInt64(10)) }, Inf(1), Overflow | Inexact},
+		{"tiny * 0.1", func(c *Context) (Decimal, error) { return c.Mul(tiny, New(1, -1)) }, zero(false), Underflow | Inexact},
+		{"tiny * 1.5", func(c *Context) (Decimal, error) { return c.Mul(tiny, New(15, -1)) }, MustParse("2e-6176"), Underflow | Inexact},
+		{"Inf * 0", func(c *Context) (Decimal, error) { return c.Mul(Inf(1), zero(false)) }, NaN(), InvalidOperation},
+		{"1 / 4", func(c *Context) (Decimal, error) { return c.Quo(FromInt64(1), FromInt64(4)) }, New(25, -2), 0},
+		{"1 / 3", func(c *Context) (Decimal, error) { return c.Quo(FromInt64(1), three) }, MustParse("0.3333333333333333333333333333333333"), Inexact},
+		{"1 / 0", func(c *Context) (Decimal, error) { return c.Quo(FromInt64(1), zero(false)) }, Inf(1), DivisionByZero},
+		{"0 / 0", func(c *Context) (Decimal, error) { return c.Quo(zero(false), zero(false)) }, NaN(), InvalidOperation},
+		{"0 / 3", func(c *Context) (Decimal, error) { return c.Quo(zero(false), three) }, zero(false), 0},
+		{"sqrt(2.25)", func(c *Context) (Decimal, error) { return c.Sqrt(New(225, -2)) }, New(15, -1), 0},
+		{"sqrt(2)", func(c *Context) (Decimal, error) { return c.Sqrt(FromInt64(2)) }, MustParse("1.414213562373095048801688724209698"), Inexact},
+		{"sqrt(-1)", func(c *Context) (Decimal, error) { return c.Sqrt(FromInt64(-1)) }, NaN(), InvalidOperation},
+		{"exp(0)", func(c *Context) (Decimal, error) { return c.Exp(zero(false)) }, FromInt64(1), 0},
+		{"exp(1)", func(c *Context) (Decimal, error) { return c.Exp(FromInt64(1)) }, MustParse("2.718281828459045235360287471352662"), Inexact},
+		{"exp(1e6)", func(c *Context) (Decimal, error) { return c.Exp(New(1, 6)) }, Inf(1), Overflow | Inexact},
+		{"exp(-1e6)", func(c *Context) (Decimal, error) { return c.Exp(New(-1, 6)) }, zero(false), Underflow | Inexact},
+		{"log(1)", func(c *Context) (Decimal, error) { return c.Log(FromInt64(1)) }, zero(false), 0},
+		{"log(10)", func(c *Context) (Decimal, error) { return c.Log(FromInt64(10)) }, MustParse("2.302585092994045684017991454684364"), Inexact},
+		{"log(0)", func(c *Context) (Decimal, error) { return c.Log(zero(false)) }, Inf(-1), DivisionByZero},
+		{"log(-1)", func(c *Context) (Decimal, error) { return c.Log(FromInt64(-1)) }, NaN(), InvalidOperation},
+	}
+
+	for _, tt := range tests {
+		var ctx Context
+		res, err := tt.op(&ctx)
+
+		if !resultEqual(res, tt.res) || ctx.Flags != tt.cond || err != nil {
+			t.Errorf("%s = (%v, %v, %v), want (%v, %v, <nil>)", tt.name, res, ctx.Flags, err, tt.res, tt.cond)
+		}
+
+		if tt.cond == 0 {
+			continue
+		}
+
+		ctx = Context{Traps: tt.cond}
+		res, err = tt.op(&ctx)
+
+		if !resultEqual(res, tt.res) || ctx.Flags != tt.cond || err != tt.cond {
+			t.Errorf("%s with traps = (%v, %v, %v), want (%v, %v, %v)", tt.name, res, ctx.Flags, err, tt.res, tt.cond, tt.cond)
+		}
+	}
+}
+
+func TestContextParse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s    string
+		mode RoundingMode
+		res  Decimal
+		cond Condition
+	}{
+		{"1.5", ToNearestEven, New(15, -1), 0},
+		{"NaN", ToNearestEven, NaN(), 0},
+		{"1.000000000000000000000000000000000000001", ToNearestEven, FromInt64(1), Inexact},
+		{"1.000000000000000000000000000000000000001", ToPositiveInf, MustParse("1.0000000000000000000000000000000001"), Inexact},
+		{"1e-7000", ToNearestEven, zero(false), Underflow | Inexact},
+		{"0e-7000", ToNearestEven, zero(false), 0},
+		{"1.5e-6176", ToNearestEven, MustParse("2e-6176"), Underflow | Inexact},
+	}
+
+	for _, tt := range tests {
+		ctx := Context{Mode: tt.mode}
+		res, err := ctx.Parse(tt.s)
+
+		if !resultEqual(res, tt.res) || ctx.Flags != tt.cond || err != nil {
+			t.Errorf("Parse(%s) with %v = (%v, %v, %v), want (%v, %v, <nil>)", tt.s, tt.mode, res, ctx.Flags, err, tt.res, tt.cond)
+		}
+	}
+
+	var ctx Context
+	res, err := ctx.Parse("1e999999999")
+	if !res.IsInf(1) || ctx.Flags != Overflow|Inexact || !errors.Is(err, strconv.ErrRange) {
+		t.Errorf("Parse(1e999999999) = (%v, %v, %v), want (+Inf, Overflow|Inexact, value out of range)", res, ctx.Flags, err)
+	}
+
+	ctx = Context{}
+	_, err = ctx.Parse("1x")
+	if ctx.Flags != 0 || !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("Parse(1x) = (%v, %v), want (Condition(0), invalid syntax)", ctx.Flags, err)
+	}
+}
+
+func TestContextSticky(t *testing.T) {
+	t.Parallel()
+
+	ctx := Context{Mode: ToZero}
+
+	x, _ := ctx.Quo(FromInt64(2), FromInt64(3))
+	if ctx.Flags != Inexact {
+		t.Errorf("2 / 3 flags = %v, want Inexact", ctx.Flags)
+	}
+
+	if want := MustParse("0.6666666666666666666666666666666666"); !x.Equal(want) {
+		t.Errorf("2 / 3 = %v, want %v", x, want)
+	}
+
+	x, _ = ctx.Add(FromInt64(1), FromInt64(1))
+	if ctx.Flags != Inexact || !x.Equal(FromInt64(2)) {
+		t.Errorf("1 + 1 = (%v, %v), want (2, Inexact)", x, ctx.Flags)
+	}
+}
@@ -6,8 +6,15 @@ import (
 	"math/bits"
 )
 
-// FromFloat converts f into a Decimal.
+// FromFloat converts f into a Decimal, rounding using the
+// [DefaultRoundingMode].
 func FromFloat(f *big.Float) Decimal {
+	return FromFloatWithMode(f, DefaultRoundingMode)
+}
+
+// FromFloatWithMode converts f into a Decimal, rounding using the provided
+// rounding mode.
+func FromFloatWithMode(f *big.Float, mode RoundingMode) Decimal {
 	if f.IsInf() {
 		return inf(f.Signbit())
 	}
@@ -17,20 +24,34 @@ func FromFloat(f *big.Float) Decimal {
 	}
 
 	r, _ := f.Rat(nil)
-	return FromRat(r)
+	return FromRatWithMode(r, mode)
 }
 
-// FromFloat32 converts f into a Decimal.
+// FromFloat32 converts f into a Decimal, rounding using the
+// [DefaultRoundingMode].
 func FromFloat32(f float32) Decimal {
+	return FromFloat32WithMode(f, DefaultRoundingMode)
+}
+
+// FromFloat32WithMode converts f into a Decimal, rounding using the provided
+// rounding mode.
+func FromFloat32WithMode(f float32, mode RoundingMode) Decimal {
 	if math.IsNaN(float64(f)) {
 		return nan(payloadOpFromFloat32, 0, 0)
 	}
 
-	return FromFloat64(float64(f))
+	return FromFloat64WithMode(float64(f), mode)
 }
 
-// FromFloat64 converts f into a Decimal.
+// FromFloat64 converts f into a Decimal, rounding using the
+// [DefaultRoundingMode].
 func FromFloat64(f float64) Decimal {
+	return FromFloat64WithMode(f, DefaultRoundingMode)
+}
+
+// FromFloat64WithMode converts f into a Decimal, rounding using the provided
+// rounding mode.
+func FromFloat64WithMode(f float64, mode RoundingMode) Decimal {
 	if math.IsNaN(f) {
 		return nan(payloadOpFromFloat64, 0, 0)
 	}
@@ -145,7 +166,7 @@ func FromFloat64(f float64) Decimal {
 		}
 	}
 
-	sig, exp := DefaultRoundingMode.reduce256(neg, sig256, exp, trunc)
+	sig, exp := mode.reduce256(neg, sig256, exp, trunc)
 
 	if exp > maxBiasedExponent {
 		return inf(neg)
@@ -154,8 +175,14 @@ func FromFloat64(f float64) Decimal {
 	return compose(neg, sig, exp)
 }
 
-// FromInt converts i into a Decimal.
+// FromInt converts i into a Decimal, rounding using the [DefaultRoundingMode].
 func FromInt(i *big.Int) Decimal {
+	return FromIntWithMode(i, DefaultRoundingMode)
+}
+
+// FromIntWithMode converts i into a Decimal, rounding using the provided
+// rounding mode.
+func FromIntWithMode(i *big.Int, mode RoundingMode) Decimal {
 	neg := false
 	if sgn := i.Sign(); sgn == 0 {
 		return zero(false)
@@ -163,59 +190,7 @@ func FromInt(i *big.Int) Decimal {
 		neg = true
 	}
 
-	exp := int16(exponentBias)
-	trunc := int8(0)
-
-	if bl := i.BitLen(); bl > 128 {
-		i = new(big.Int).Set(i)
-		r := new(big.Int)
-
-		if bl > 256 {
-			e18 := big.NewInt(1_000_000_000_000_000_000)
-
-			for bl > 256 {
-				i.QuoRem(i, e18, r)
-				exp += 18
-
-				if exp > maxBiasedExponent {
-					return inf(neg)
-				}
-
-				bl = i.BitLen()
-
-				if r.Sign() != 0 {
-					trunc = 1
-				}
-			}
-		}
-
-		ten := big.NewInt(10)
-
-		for bl > 128 {
-			i.QuoRem(i, ten, r)
-			exp++
-
-			if exp > maxBiasedExponent {
-				return inf(neg)
-			}
-
-			bl = i.BitLen()
-
-			if r.Sign() != 0 {
-				trunc = 1
-			}
-		}
-	}
-
-	var sig uint128
-
-	b := i.Bits()
-	for i := len(b) - 1; i >= 0; i-- {
-		sig = sig.lsh(bits.UintSize)
-		sig = sig.or64(uint64(b[i]))
-	}
-
-	sig, exp = DefaultRoundingMode.reduce128(neg, sig, exp, trunc)
+	sig, exp := mode.reduceBig(neg, i, exponentBias)
 
 	if exp > maxBiasedExponent {
 		return inf(neg)
@@ -244,17 +219,80 @@ func FromInt64(i int64) Decimal {
 	return compose(neg, uint128{uint64(i), 0}, exponentBias)
 }
 
-// FromRat converts r into a Decimal.
+// FromRat converts r into a Decimal, rounding using the [DefaultRoundingMode].
 func FromRat(r *big.Rat) Decimal {
+	return FromRatWithMode(r, DefaultRoundingMode)
+}
+
+// FromRatWithMode converts r into a Decimal, rounding using the provided
+// rounding mode. The result is rounded once, directly from the exact value of
+// r.
+func FromRatWithMode(r *big.Rat, mode RoundingMode) Decimal {
 	num := r.Num()
 
 	if num.Sign() == 0 {
 		return zero(false)
 	}
 
-	denom := r.Denom()
+	if r.IsInt() {
+		return FromIntWithMode(num, mode)
+	}
+
+	neg := num.Sign() < 0
+	num = new(big.Int).Abs(num)
+	denom := new(big.Int).Set(r.Denom())
+
+	// Scale the quotient so that it has more digits than a Decimal can hold,
+	// then append a final digit recording whether the division was inexact,
+	// which lets the quotient be rounded correctly in a single step.
+	shift := (denom.BitLen()-num.BitLen())*30103/100000 + maxDigits + 3
+	exp := exponentBias - shift - 1
+
+	if exp > maxBiasedExponent {
+		return inf(neg)
+	}
+
+	if exp < minBiasedExponent-2*maxDigits {
+		return zero(neg)
+	}
+
+	ten := big.NewInt(10)
+
+	if shift > 0 {
+		num.Mul(num, new(big.Int).Exp(ten, big.NewInt(int64(shift)), nil))
+	} else if shift < 0 {
+		denom.Mul(denom, new(big.Int).Exp(ten, big.NewInt(int64(-shift)), nil))
+	}
+
+	rem := new(big.Int)
+	num.QuoRem(num, denom, rem)
+
+	if rem.Sign() != 0 {
+		num.Mul(num, ten)
+		num.Add(num, big.NewInt(1))
+	} else {
+		// The quotient is exact, so remove the trailing zeros introduced by
+		// the scaling, as far as an exponent of zero.
+		exp++
+
+		for exp < exponentBias {
+			var q big.Int
+			if q.QuoRem(num, ten, rem); rem.Sign() != 0 {
+				break
+			}
+
+			num.Set(&q)
+			exp++
+		}
+	}
+
+	sig, exp16 := mode.reduceBig(neg, num, int16(exp))
+
+	if exp16 > maxBiasedExponent {
+		return inf(neg)
+	}
 
-	return FromInt(num).Quo(FromInt(denom))
+	return compose(neg, sig, exp16)
 }
 
 // FromUint32 converts i into a Decimal.
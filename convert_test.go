@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"math/rand/v2"
 	"testing"
 )
 
@@ -352,3 +353,119 @@ func TestDecimalUint64(t *testing.T) {
 		}
 	}
 }
+
+func TestFromFloat64WithMode(t *testing.T) {
+	t.Parallel()
+
+	rnd := rand.New(rand.NewPCG(1, 2))
+
+	for range 2000 {
+		f := math.Float64frombits(rnd.Uint64())
+		if math.IsNaN(f) || math.IsInf(f, 0) || f == 0 {
+			continue
+		}
+
+		r, _ := new(big.Float).SetFloat64(f).Rat(nil)
+
+		for _, mode := range roundingModes {
+			res := FromFloat64WithMode(f, mode)
+			want := fromRatOracle(t, r, mode)
+
+			if !res.Equal(want) {
+				t.Errorf("FromFloat64WithMode(%g, %v) = %v, want %v", f, mode, res, want)
+			}
+		}
+	}
+}
+
+func TestFromRatWithMode(t *testing.T) {
+	t.Parallel()
+
+	values := []struct {
+		num, denom int64
+		exp        int
+		mode       RoundingMode
+		res        string
+	}{
+		{1, 4, 0, ToNearestEven, "0.25"},
+		{25, 2, 0, ToNearestEven, "12.5"},
+		{2, 3, 0, ToZero, "0.6666666666666666666666666666666666"},
+		{2, 3, 0, AwayFromZero, "0.6666666666666666666666666666666667"},
+		{-2, 3, 0, ToNegativeInf, "-0.6666666666666666666666666666666667"},
+		{-2, 3, 0, ToPositiveInf, "-0.6666666666666666666666666666666666"},
+		{1, 3, -6200, ToNearestEven, "0"},
+		{1, 3, 6200, ToNearestEven, "Inf"},
+		{1, 3, 6144, ToZero, "3.333333333333333333333333333333333e6143"},
+		{1, 3, -6150, ToZero, "3.3333333333333333333333333e-6151"},
+		{1, 3, -6150, AwayFromZero, "3.3333333333333333333333334e-6151"},
+	}
+
+	for _, val := range values {
+		r := big.NewRat(val.num, val.denom)
+		if val.exp > 0 {
+			r.Mul(r, new(big.Rat).SetInt(oraclePow10(val.exp)))
+		} else if val.exp < 0 {
+			r.Quo(r, new(big.Rat).SetInt(oraclePow10(val.exp)))
+		}
+
+		res := FromRatWithMode(r, val.mode)
+		want := MustParse(val.res)
+
+		if !resultEqual(res, want) {
+			t.Errorf("FromRatWithMode(%d/%de%d, %v) = %v, want %v", val.num, val.denom, val.exp, val.mode, res, want)
+		}
+	}
+
+	rnd := rand.New(rand.NewPCG(1, 2))
+
+	for range 1000 {
+		num := fromRatRandom(rnd)
+		denom := fromRatRandom(rnd)
+		if num.Sign() == 0 || denom.Sign() == 0 {
+			continue
+		}
+
+		r := new(big.Rat).SetFrac(num, denom)
+		if rnd.IntN(2) == 0 {
+			r.Neg(r)
+		}
+
+		if rnd.IntN(4) == 0 {
+			r.Mul(r, new(big.Rat).SetFrac(big.NewInt(1), oraclePow10(6100+rnd.IntN(100))))
+		}
+
+		for _, mode := range roundingModes {
+			res := FromRatWithMode(r, mode)
+			want := fromRatOracle(t, r, mode)
+
+			if !res.Equal(want) || res.Signbit() != want.Signbit() {
+				t.Errorf("FromRatWithMode(%v, %v) = %v, want %v", r, mode, res, want)
+			}
+		}
+	}
+}
+
+func fromRatRandom(rnd *rand.Rand) *big.Int {
+	i := new(big.Int)
+	for range 1 + rnd.IntN(4) {
+		i.Lsh(i, 64)
+		i.Or(i, new(big.Int).SetUint64(rnd.Uint64()))
+	}
+
+	return i.Rsh(i, uint(rnd.IntN(64)))
+}
+
+func fromRatOracle(t *testing.T, r *big.Rat, mode RoundingMode) Decimal {
+	t.Helper()
+
+	exact := func(c *big.Rat) bool {
+		return c.Cmp(r) == 0
+	}
+
+	res, ok := oracleRound(new(big.Float).SetPrec(1024).SetRat(r), 1024, exact, mode)
+	if !ok {
+		t.Fatalf("oracleRound(%v) undecided", r)
+	}
+
+	return res
+}
@@ -1,6 +1,11 @@
 // Package decimal128 provides a 128-bit decimal floating point type.
 package decimal128
 
+import (
+	"math"
+	"math/big"
+)
+
 const (
 	exponentBias        = 6176
 	maxBiasedExponent   = 12287
@@ -25,8 +30,12 @@ func Abs(d Decimal) Decimal {
 // ten. The absolute value of the fraction will be in the interval [0.1, 1).
 //
 // If d is ±Inf, NaN, or zero the value is returned unchanged and the returned
-// power of ten is zero.
+// power of ten is zero, except that a signaling NaN is returned as a quiet NaN.
 func Frexp(d Decimal) (Decimal, int) {
+	if d.IsNaN() {
+		return d.quiet(), 0
+	}
+
 	if d.isSpecial() || d.IsZero() {
 		return d, 0
 	}
@@ -38,14 +47,47 @@ func Frexp(d Decimal) (Decimal, int) {
 	return compose(d.Signbit(), sig, exp), rexp
 }
 
+// Ilogb returns the exponent of the most significant digit of d as an
+// integer, that is, the integer part of log10(|d|). Unlike [Frexp], the result
+// does not depend on how many digits d is stored with.
+//
+// Special cases are:
+//
+//	Ilogb(±Inf) = MaxInt32
+//	Ilogb(0) = MinInt32
+//	Ilogb(NaN) = MaxInt32
+func Ilogb(d Decimal) int {
+	if d.isSpecial() {
+		return math.MaxInt32
+	}
+
+	if d.IsZero() {
+		return math.MinInt32
+	}
+
+	sig, exp := d.decompose()
+	return int(exp) - exponentBias + sig.log10()
+}
+
 // Inf returns a new Decimal set to positive infinity if sign >= 0, or negative
 // infinity if sign < 0.
 func Inf(sign int) Decimal {
 	return inf(sign < 0)
 }
 
-// Ldexp is the inverse of [Frexp], returning frac × 10**exp.
+// Ldexp is the inverse of [Frexp], returning frac × 10**exp, rounded using the
+// [DefaultRoundingMode].
 func Ldexp(frac Decimal, exp int) Decimal {
+	return LdexpWithMode(frac, exp, DefaultRoundingMode)
+}
+
+// LdexpWithMode is the inverse of [Frexp], returning frac × 10**exp, rounded
+// using the provided rounding mode.
+func LdexpWithMode(frac Decimal, exp int, mode RoundingMode) Decimal {
+	if frac.IsNaN() {
+		return frac.quiet()
+	}
+
 	if frac.isSpecial() || frac.IsZero() {
 		return frac
 	}
@@ -62,7 +104,7 @@ func Ldexp(frac Decimal, exp int) Decimal {
 	fsig, fexp := frac.decompose()
 	fexp += int16(exp)
 
-	sig, exp16 := DefaultRoundingMode.reduce128(neg, fsig, fexp, 0)
+	sig, exp16 := mode.reduce128(neg, fsig, fexp, 0)
 
 	if exp16 > maxBiasedExponent {
 		return inf(neg)
@@ -71,13 +113,46 @@ func Ldexp(frac Decimal, exp int) Decimal {
 	return compose(neg, sig, exp16)
 }
 
+// Logb returns the exponent of the most significant digit of d, that is, the
+// integer part of log10(|d|). The result is always exact.
+//
+// Special cases are:
+//
+//	Logb(±Inf) = +Inf
+//	Logb(0) = -Inf
+//	Logb(NaN) = NaN
+func Logb(d Decimal) Decimal {
+	if d.isSpecial() {
+		if d.IsNaN() {
+			return d.quiet()
+		}
+
+		return inf(false)
+	}
+
+	if d.IsZero() {
+		return inf(true)
+	}
+
+	return FromInt64(int64(Ilogb(d)))
+}
+
 // NaN returns a new Decimal set to the "not-a-number" value.
 func NaN() Decimal {
 	return nan(payloadOpNaN, 0, 0)
 }
 
-// New returns a new Decimal with the provided significand and exponent.
+// New returns a new Decimal with the provided significand and exponent,
+// rounded using the [DefaultRoundingMode] if it is outside the range of
+// exponents that can be represented exactly.
 func New(sig int64, exp int) Decimal {
+	return NewWithMode(sig, exp, DefaultRoundingMode)
+}
+
+// NewWithMode returns a new Decimal with the provided significand and exponent,
+// rounded using the provided rounding mode if it is outside the range of
+// exponents that can be represented exactly.
+func NewWithMode(sig int64, exp int, mode RoundingMode) Decimal {
 	if sig == 0 {
 		return zero(false)
 	}
@@ -88,7 +163,7 @@ func New(sig int64, exp int) Decimal {
 		sig *= -1
 	}
 
-	if exp < minUnbiasedExponent+19 {
+	if exp < minUnbiasedExponent-20 {
 		return zero(neg)
 	}
 
@@ -96,7 +171,7 @@ func New(sig int64, exp int) Decimal {
 		return inf(neg)
 	}
 
-	sig128, exp16 := DefaultRoundingMode.reduce64(neg, uint64(sig), int16(exp+exponentBias))
+	sig128, exp16 := mode.reduce64(neg, uint64(sig), int16(exp+exponentBias))
 
 	if exp16 > maxBiasedExponent {
 		return inf(neg)
@@ -105,6 +180,171 @@ func New(sig int64, exp int) Decimal {
 	return compose(neg, sig128, exp16)
 }
 
+// NextAfter returns the next representable Decimal value after d in the
+// direction of toward. If d and toward are equal, toward is returned. If
+// either value is NaN, the result is NaN.
+func NextAfter(d, toward Decimal) Decimal {
+	if d.IsNaN() {
+		return d.quiet()
+	}
+
+	if toward.IsNaN() {
+		return toward.quiet()
+	}
+
+	switch d.Cmp(toward) {
+	case -1:
+		return NextUp(d)
+	case 1:
+		return NextDown(d)
+	default:
+		return toward
+	}
+}
+
+// NextDown returns the largest representable Decimal value that is less than
+// d. NextDown(+Inf) is the largest finite Decimal value, and NextDown(-Inf) is
+// -Inf.
+func NextDown(d Decimal) Decimal {
+	return NextUp(d.Neg()).Neg()
+}
+
+// NextUp returns the smallest representable Decimal value that is greater than
+// d. NextUp(-Inf) is the smallest finite Decimal value, and NextUp(+Inf) is
+// +Inf.
+func NextUp(d Decimal) Decimal {
+	if d.isSpecial() {
+		if d.IsNaN() {
+			return d.quiet()
+		}
+
+		if d.Signbit() {
+			return compose(true, uint128{0xffff_ffff_ffff_ffff, 0x0002_7fff_ffff_ffff}, maxBiasedExponent)
+		}
+
+		return inf(false)
+	}
+
+	sig, exp := d.decompose()
+
+	if sig[0]|sig[1] == 0 {
+		return compose(false, uint128{1, 0}, minBiasedExponent)
+	}
+
+	sig, exp = normalise(sig, exp)
+
+	if d.Signbit() {
+		if exp > minBiasedExponent {
+			tmp := sig.mul64(10).sub64(1)
+
+			if tmp[1] <= 0x0002_7fff_ffff_ffff {
+				return compose(true, tmp, exp-1)
+			}
+		}
+
+		sig = sig.sub64(1)
+
+		if sig[0]|sig[1] == 0 {
+			return zero(true)
+		}
+
+		return compose(true, sig, exp)
+	}
+
+	if sig == (uint128{0xffff_ffff_ffff_ffff, 0x0002_7fff_ffff_ffff}) {
+		sig, _ = sig.div10()
+		exp++
+	}
+
+	sig = sig.add64(1)
+
+	if exp > maxBiasedExponent {
+		return inf(false)
+	}
+
+	return compose(false, sig, exp)
+}
+
+// Scaleb returns d × 10**n, rounded using the [DefaultRoundingMode].
+func Scaleb(d Decimal, n int) Decimal {
+	return ScalebWithMode(d, n, DefaultRoundingMode)
+}
+
+// ScalebWithMode returns d × 10**n, rounded using the provided rounding mode.
+//
+// The result is calculated by adjusting the exponent of d, so it is exact
+// unless it overflows or becomes subnormal. Zeros keep their sign, with the
+// exponent adjusted as far as the representable range allows.
+//
+// Special cases are:
+//
+//	Scaleb(±Inf, n) = ±Inf
+//	Scaleb(NaN, n) = NaN
+func ScalebWithMode(d Decimal, n int, mode RoundingMode) Decimal {
+	if d.isSpecial() {
+		if d.IsNaN() {
+			return d.quiet()
+		}
+
+		return d
+	}
+
+	neg := d.Signbit()
+	sig, exp := d.decompose()
+
+	if sig[0]|sig[1] == 0 {
+		n = max(n, minBiasedExponent-int(exp))
+		n = min(n, maxBiasedExponent-int(exp))
+		return compose(neg, sig, exp+int16(n))
+	}
+
+	if n < minBiasedExponent-int(exp)-2*maxDigits {
+		return zero(neg)
+	}
+
+	if n > maxBiasedExponent-int(exp)+maxDigits {
+		return inf(neg)
+	}
+
+	sig, exp = mode.reduce128(neg, sig, exp+int16(n), 0)
+
+	if exp > maxBiasedExponent {
+		return inf(neg)
+	}
+
+	return compose(neg, sig, exp)
+}
+
+// SignalingNaN returns a new Decimal set to a signaling "not-a-number" value
+// with the provided payload. Operations given a signaling NaN return a quiet
+// NaN with the same payload.
+func SignalingNaN(payload Payload) Decimal {
+	return Decimal{uint64(payload), 0x7e00_0000_0000_0000}
+}
+
+// Ulp returns the unit in the last place of d: the value of its least
+// significant digit when d is written using as many significant digits as a
+// Decimal can hold. The result is always positive. Ulp(±0) is the smallest
+// positive Decimal value, Ulp(±Inf) is +Inf, and Ulp(NaN) is NaN.
+func Ulp(d Decimal) Decimal {
+	if d.isSpecial() {
+		if d.IsNaN() {
+			return d.quiet()
+		}
+
+		return inf(false)
+	}
+
+	sig, exp := d.decompose()
+
+	if sig[0]|sig[1] == 0 {
+		return compose(false, uint128{1, 0}, minBiasedExponent)
+	}
+
+	_, exp = normalise(sig, exp)
+	return compose(false, uint128{1, 0}, exp)
+}
+
 func compose(neg bool, sig uint128, exp int16) Decimal {
 	var hi uint64
 	if sig[1] > 0x0001_ffff_ffff_ffff {
@@ -132,6 +372,21 @@ func nan(op, lhs, rhs Payload) Decimal {
 	return Decimal{uint64(op | lhs<<8 | rhs<<16), 0x7c00_0000_0000_0000}
 }
 
+func normalise(sig uint128, exp int16) (uint128, int16) {
+	for exp > minBiasedExponent {
+		tmp := sig.mul64(10)
+
+		if tmp[1] > 0x0002_7fff_ffff_ffff {
+			break
+		}
+
+		sig = tmp
+		exp--
+	}
+
+	return sig, exp
+}
+
 func one(neg bool) Decimal {
 	if neg {
 		return Decimal{1, 0xb040_0000_0000_0000}
@@ -155,7 +410,7 @@ func zero(neg bool) Decimal {
 // If d is ±Inf or NaN, the canonical representation consists of only the bits
 // required to represent the respective special floating point value with all
 // other bits set to 0. For NaN values this also removes any payload it may
-// have had.
+// have had, although a signaling NaN remains signaling.
 //
 // If d is ±0, the canonical representation consists of only the sign bit set
 // based on the sign of the value with all other bits set to 0.
@@ -166,7 +421,7 @@ func zero(neg bool) Decimal {
 func (d Decimal) Canonical() Decimal {
 	if d.isSpecial() {
 		if d.IsNaN() {
-			return nan(0, 0, 0)
+			return Decimal{0, d.hi & 0x7e00_0000_0000_0000}
 		}
 
 		return inf(d.Signbit())
@@ -203,6 +458,71 @@ func (d Decimal) Canonical() Decimal {
 	return compose(d.Signbit(), sig, exp)
 }
 
+// Coefficient returns the integer coefficient of d as it is stored, such that
+// d is equal to Coefficient × 10^Exponent. The coefficient carries the sign of
+// d. If a non-nil argument i is provided, Coefficient stores the result in i
+// instead of allocating a new big.Int. It panics if d is NaN or infinite.
+//
+// The coefficient depends on which member of the cohort of equal values d is
+// stored as, so 1.50 and 1.5 have different coefficients. Use
+// [Decimal.Canonical] first if a consistent representation is required.
+func (d Decimal) Coefficient(i *big.Int) *big.Int {
+	if d.isSpecial() {
+		if d.IsNaN() {
+			panic("Decimal(NaN).Coefficient()")
+		}
+
+		if d.Signbit() {
+			panic("Decimal(-Inf).Coefficient()")
+		}
+
+		panic("Decimal(+Inf).Coefficient()")
+	}
+
+	sig, _ := d.decompose()
+
+	if i == nil {
+		i = new(big.Int)
+	}
+
+	if sig[1] == 0 {
+		i.SetUint64(sig[0])
+	} else {
+		i.SetUint64(sig[1])
+		i.Lsh(i, 64).Or(i, new(big.Int).SetUint64(sig[0]))
+	}
+
+	if d.Signbit() {
+		i.Neg(i)
+	}
+
+	return i
+}
+
+// Exponent returns the exponent of d as it is stored, such that d is equal to
+// Coefficient × 10^Exponent. It panics if d is NaN or infinite.
+//
+// Zero values created by this package, including the zero value of Decimal
+// and zero results of parsing and arithmetic, are stored with the smallest
+// exponent, -6176. Use [Decimal.Rescale] or [Decimal.Quantize] to give a zero
+// a particular exponent.
+func (d Decimal) Exponent() int {
+	if d.isSpecial() {
+		if d.IsNaN() {
+			panic("Decimal(NaN).Exponent()")
+		}
+
+		if d.Signbit() {
+			panic("Decimal(-Inf).Exponent()")
+		}
+
+		panic("Decimal(+Inf).Exponent()")
+	}
+
+	_, exp := d.decompose()
+	return int(exp) - exponentBias
+}
+
 // IsInf reports whether d is an infinity. If sign > 0, IsInf reports whether
 // d is positive infinity. If sign < 0, IsInf reports whether d is negative
 // infinity. If sign == 0, IsInf reports whether d is either infinity.
@@ -222,16 +542,72 @@ func (d Decimal) IsInf(sign int) bool {
 	return d.Signbit()
 }
 
-// IsNaN reports whether d is a "not-a-number" value.
+// IsNaN reports whether d is a "not-a-number" value, either quiet or
+// signaling.
 func (d Decimal) IsNaN() bool {
 	return d.hi&0x7c00_0000_0000_0000 == 0x7c00_0000_0000_0000
 }
 
+// IsSignalingNaN reports whether d is a signaling "not-a-number" value.
+func (d Decimal) IsSignalingNaN() bool {
+	return d.hi&0x7e00_0000_0000_0000 == 0x7e00_0000_0000_0000
+}
+
 // Neg returns d with its sign negated.
 func (d Decimal) Neg() Decimal {
 	return Decimal{d.lo, d.hi ^ 0x8000_0000_0000_0000}
 }
 
+// Precision returns the number of digits in the coefficient of d as it is
+// stored, ignoring the sign. Zero is considered to have a single digit. It
+// panics if d is NaN or infinite.
+//
+// The precision of zero is 1 however many digits it was written with, and
+// zero values are usually stored with an exponent of -6176; see
+// [Decimal.Scale].
+func (d Decimal) Precision() int {
+	if d.isSpecial() {
+		if d.IsNaN() {
+			panic("Decimal(NaN).Precision()")
+		}
+
+		if d.Signbit() {
+			panic("Decimal(-Inf).Precision()")
+		}
+
+		panic("Decimal(+Inf).Precision()")
+	}
+
+	sig, _ := d.decompose()
+	return sig.log10() + 1
+}
+
+// Scale returns the number of digits after the decimal point in d as it is
+// stored, which is the negation of [Decimal.Exponent]. The scale is negative
+// if d is stored with a positive exponent. It panics if d is NaN or infinite.
+//
+// Zero values created by this package, including the zero value of Decimal,
+// a parsed "0.00" and the result of x.Sub(x), are stored with an exponent of
+// -6176, so their scale is 6176. Call [Decimal.Rescale] or [Decimal.Quantize]
+// first when a zero needs a meaningful scale, such as when validating against
+// a fixed number of decimal places.
+func (d Decimal) Scale() int {
+	if d.isSpecial() {
+		if d.IsNaN() {
+			panic("Decimal(NaN).Scale()")
+		}
+
+		if d.Signbit() {
+			panic("Decimal(-Inf).Scale()")
+		}
+
+		panic("Decimal(+Inf).Scale()")
+	}
+
+	_, exp := d.decompose()
+	return exponentBias - int(exp)
+}
+
 // Sign returns:
 //
 //	-1 if d <   0
@@ -282,3 +658,7 @@ func (d Decimal) isInf() bool {
 func (d Decimal) isSpecial() bool {
 	return d.hi&0x7800_0000_0000_0000 == 0x7800_0000_0000_0000
 }
+
+func (d Decimal) quiet() Decimal {
+	return Decimal{d.lo, d.hi &^ 0x0200_0000_0000_0000}
+}
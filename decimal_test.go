@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"math/big"
 	"os"
 	"path/filepath"
 	"sync"
@@ -209,7 +210,7 @@ func (tr *testDataReader) scan(format string, args ...any) bool {
 
 		n, err := fmt.Fscanf(tr.r, format, args...)
 		if err != nil {
-			if errors.Is(err, io.ErrUnexpectedEOF) {
+			if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
 				if n != 0 {
 					tr.t.Fatalf("error reading file %s: %v", tr.f.Name(), err)
 				}
@@ -229,12 +230,15 @@ func (tr *testDataReader) scan(format string, args ...any) bool {
 }
 
 type testDataResult struct {
-	ToNearestEven Decimal
-	ToNearestAway Decimal
-	ToZero        Decimal
-	AwayFromZero  Decimal
-	ToNegativeInf Decimal
-	ToPositiveInf Decimal
+	ToNearestEven       Decimal
+	ToNearestAway       Decimal
+	ToZero              Decimal
+	AwayFromZero        Decimal
+	ToNegativeInf       Decimal
+	ToPositiveInf       Decimal
+	ToNearestTowardZero Decimal
+	ToZero05Up          Decimal
+	ToOdd               Decimal
 }
 
 func (tr *testDataResult) Scan(f fmt.ScanState, verb rune) error {
@@ -268,6 +272,8 @@ func (tr *testDataResult) Scan(f fmt.ScanState, verb rune) error {
 	tr.ToNegativeInf = res
 	tr.ToPositiveInf = res
 
+	var explicit [3]bool
+
 	for index != -1 {
 		tok = tok[index+1:]
 
@@ -314,15 +320,70 @@ func (tr *testDataResult) Scan(f fmt.ScanState, verb rune) error {
 				tr.ToNegativeInf = res
 			case "PI":
 				tr.ToPositiveInf = res
+			case "NZ":
+				tr.ToNearestTowardZero = res
+				explicit[0] = true
+			case "Z5":
+				tr.ToZero05Up = res
+				explicit[1] = true
+			case "O":
+				tr.ToOdd = res
+				explicit[2] = true
 			default:
 				return errors.New("invalid value \"" + string(mode) + "\"")
 			}
 		}
 	}
 
+	tr.derive(explicit)
 	return nil
 }
 
+// derive sets the results of the rounding modes that weren't given explicitly
+// from the results of the other modes. When a result is inexact, the ToZero and
+// AwayFromZero results are adjacent, so the difference between them gives the
+// last digit of the coefficient of the ToZero result. A tie that
+// ToNearestEven and ToNearestAway both round away from zero can't be
+// distinguished from a value above the halfway point, so it needs an explicit
+// ToNearestTowardZero result.
+func (tr *testDataResult) derive(explicit [3]bool) {
+	digit := int64(-1)
+
+	if !resultEqual(tr.ToZero, tr.AwayFromZero) {
+		if tr.AwayFromZero.isInf() {
+			digit = 9
+		} else {
+			ulp := new(big.Rat).Sub(oracleRat(tr.AwayFromZero), oracleRat(tr.ToZero))
+			sig := new(big.Rat).Quo(oracleRat(tr.ToZero), ulp.Abs(ulp))
+			digit = new(big.Int).Rem(new(big.Int).Abs(sig.Num()), big.NewInt(10)).Int64()
+		}
+	}
+
+	if !explicit[0] {
+		if resultEqual(tr.ToNearestEven, tr.ToNearestAway) {
+			tr.ToNearestTowardZero = tr.ToNearestEven
+		} else {
+			tr.ToNearestTowardZero = tr.ToZero
+		}
+	}
+
+	if !explicit[1] {
+		if digit == 0 || digit == 5 {
+			tr.ToZero05Up = tr.AwayFromZero
+		} else {
+			tr.ToZero05Up = tr.ToZero
+		}
+	}
+
+	if !explicit[2] {
+		if digit >= 0 && digit%2 == 0 {
+			tr.ToOdd = tr.AwayFromZero
+		} else {
+			tr.ToOdd = tr.ToZero
+		}
+	}
+}
+
 func (tr *testDataResult) equal(val Decimal, mode RoundingMode) bool {
 	return resultEqual(val, tr.result(mode))
 }
@@ -341,6 +402,12 @@ func (tr *testDataResult) result(mode RoundingMode) Decimal {
 		return tr.ToNegativeInf
 	case ToPositiveInf:
 		return tr.ToPositiveInf
+	case ToNearestTowardZero:
+		return tr.ToNearestTowardZero
+	case ToZero05Up:
+		return tr.ToZero05Up
+	case ToOdd:
+		return tr.ToOdd
 	default:
 		panic("invalid rounding mode " + mode.String())
 	}
@@ -400,6 +467,8 @@ func (tr *testDataResultPair) Scan(f fmt.ScanState, verb rune) error {
 	tr.second.ToNegativeInf = secondRes
 	tr.second.ToPositiveInf = secondRes
 
+	var explicit [3]bool
+
 	for index != -1 {
 		tok = tok[index+1:]
 
@@ -461,12 +530,26 @@ func (tr *testDataResultPair) Scan(f fmt.ScanState, verb rune) error {
 			case "PI":
 				tr.first.ToPositiveInf = firstRes
 				tr.second.ToPositiveInf = secondRes
+			case "NZ":
+				tr.first.ToNearestTowardZero = firstRes
+				tr.second.ToNearestTowardZero = secondRes
+				explicit[0] = true
+			case "Z5":
+				tr.first.ToZero05Up = firstRes
+				tr.second.ToZero05Up = secondRes
+				explicit[1] = true
+			case "O":
+				tr.first.ToOdd = firstRes
+				tr.second.ToOdd = secondRes
+				explicit[2] = true
 			default:
 				return errors.New("invalid value \"" + string(mode) + "\"")
 			}
 		}
 	}
 
+	tr.first.derive(explicit)
+	tr.second.derive(explicit)
 	return nil
 }
 
@@ -476,7 +559,7 @@ func (tr *testDataResultPair) equal(first, second Decimal, mode RoundingMode) bo
 
 func resultEqual(x, y Decimal) bool {
 	if x.IsNaN() && y.IsNaN() {
-		return true
+		return x.IsSignalingNaN() == y.IsSignalingNaN()
 	}
 
 	if x.Signbit() != y.Signbit() {
@@ -606,6 +689,82 @@ func TestCompose(t *testing.T) {
 	}
 }
 
+func TestDecimalCoefficient(t *testing.T) {
+	t.Parallel()
+
+	initDecimalValues()
+
+	for _, val := range decimalValues {
+		if val.form != regularForm {
+			continue
+		}
+
+		dec := val.Decimal()
+
+		sig := new(big.Int).SetUint64(val.sig[1])
+		sig.Lsh(sig, 64).Or(sig, new(big.Int).SetUint64(val.sig[0]))
+
+		prec := len(sig.String())
+
+		if val.neg {
+			sig.Neg(sig)
+		}
+
+		if res := dec.Coefficient(nil); res.Cmp(sig) != 0 {
+			t.Errorf("%v.Coefficient() = %v, want %v", val, res, sig)
+		}
+
+		exp := int(val.exp) - exponentBias
+
+		if res := dec.Exponent(); res != exp {
+			t.Errorf("%v.Exponent() = %d, want %d", val, res, exp)
+		}
+
+		if res := dec.Precision(); res != prec {
+			t.Errorf("%v.Precision() = %d, want %d", val, res, prec)
+		}
+
+		if res := dec.Scale(); res != -exp {
+			t.Errorf("%v.Scale() = %d, want %d", val, res, -exp)
+		}
+	}
+}
+
+func TestDecimalCoefficientZero(t *testing.T) {
+	t.Parallel()
+
+	x := New(1234, -2)
+
+	values := []struct {
+		name string
+		val  Decimal
+		exp  int
+	}{
+		{"Decimal{}", Decimal{}, -6176},
+		{"New(0, 0)", New(0, 0), -6176},
+		{"New(0, -2)", New(0, -2), -6176},
+		{"MustParse(0.00)", MustParse("0.00"), -6176},
+		{"x.Sub(x)", x.Sub(x), -6176},
+		{"x.Add(-x)", x.Add(x.Neg()), -6176},
+		{"Decimal{}.Rescale(2)", Decimal{}.Rescale(2, ToNearestEven), -2},
+		{"Decimal{}.Quantize(0.01)", Decimal{}.Quantize(New(1, -2), ToNearestEven), -2},
+	}
+
+	for _, val := range values {
+		if res := val.val.Exponent(); res != val.exp {
+			t.Errorf("%s.Exponent() = %d, want %d", val.name, res, val.exp)
+		}
+
+		if res := val.val.Precision(); res != 1 {
+			t.Errorf("%s.Precision() = %d, want 1", val.name, res)
+		}
+
+		if res := val.val.Scale(); res != -val.exp {
+			t.Errorf("%s.Scale() = %d, want %d", val.name, res, -val.exp)
+		}
+	}
+}
+
 func TestDecimalNeg(t *testing.T) {
 	t.Parallel()
 
@@ -662,6 +821,16 @@ func TestFrexp(t *testing.T) {
 			t.Errorf("Ldexp(%v, %d) = %v, want %v", frac, exp, res, decval)
 		}
 	}
+
+	snan := SignalingNaN(0)
+
+	if frac, exp := Frexp(snan); !frac.IsNaN() || frac.IsSignalingNaN() || exp != 0 {
+		t.Errorf("Frexp(sNaN) = (%v, %d), want (NaN, 0)", frac, exp)
+	}
+
+	if res := Ldexp(snan, 1); !res.IsNaN() || res.IsSignalingNaN() {
+		t.Errorf("Ldexp(sNaN, 1) = %v, want NaN", res)
+	}
 }
 
 func TestInf(t *testing.T) {
@@ -704,6 +873,65 @@ func TestInf(t *testing.T) {
 	}
 }
 
+func TestLdexpWithMode(t *testing.T) {
+	t.Parallel()
+
+	values := []struct {
+		frac string
+		exp  int
+		mode RoundingMode
+		res  string
+	}{
+		{"0.15", -6175, ToNearestEven, "2e-6176"},
+		{"0.15", -6175, ToZero, "1e-6176"},
+		{"-0.15", -6175, ToNegativeInf, "-2e-6176"},
+		{"-0.15", -6175, ToPositiveInf, "-1e-6176"},
+		{"0.25", -6175, ToNearestEven, "2e-6176"},
+		{"0.25", -6175, ToNearestAway, "3e-6176"},
+		{"0.5", 3, ToZero, "5e2"},
+	}
+
+	for _, val := range values {
+		frac := MustParse(val.frac)
+		res := LdexpWithMode(frac, val.exp, val.mode)
+		want := MustParse(val.res)
+
+		if !resultEqual(res, want) {
+			t.Errorf("LdexpWithMode(%v, %d, %v) = %v, want %v", frac, val.exp, val.mode, res, want)
+		}
+	}
+}
+
+func TestLogb(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var res Decimal
+
+	for r.scan("logb(%v) = %v\n", &val, &res) {
+		lgb := Logb(val)
+
+		if !resultEqual(lgb, res) {
+			t.Errorf("Logb(%v) = %v, want %v", val, lgb, res)
+		}
+
+		want := math.MaxInt32
+		if val.IsZero() {
+			want = math.MinInt32
+		} else if !val.isSpecial() {
+			exp, _ := res.Int64()
+			want = int(exp)
+		}
+
+		if ilgb := Ilogb(val); ilgb != want {
+			t.Errorf("Ilogb(%v) = %d, want %d", val, ilgb, want)
+		}
+	}
+}
+
 func TestNaN(t *testing.T) {
 	t.Parallel()
 
@@ -722,6 +950,187 @@ func TestNaN(t *testing.T) {
 	}
 }
 
+func TestNewWithMode(t *testing.T) {
+	t.Parallel()
+
+	values := []struct {
+		sig  int64
+		exp  int
+		mode RoundingMode
+		res  string
+	}{
+		{1, -6160, ToNearestEven, "1e-6160"},
+		{123, -6178, ToNearestEven, "1e-6176"},
+		{123, -6178, AwayFromZero, "2e-6176"},
+		{-123, -6178, ToNegativeInf, "-2e-6176"},
+		{-123, -6178, ToPositiveInf, "-1e-6176"},
+		{5, -6177, ToNearestEven, "0"},
+		{5, -6177, ToNearestAway, "1e-6176"},
+		{-5, -6177, ToZero, "-0"},
+		{1, -6200, AwayFromZero, "0"},
+		{1, 6144, ToNearestEven, "1e6144"},
+		{100, 6142, ToNearestEven, "1e6144"},
+		{100, 6143, ToNearestEven, "1e6145"},
+		{2, 6145, ToNearestEven, "Inf"},
+	}
+
+	for _, val := range values {
+		res := NewWithMode(val.sig, val.exp, val.mode)
+		want := MustParse(val.res)
+
+		if !resultEqual(res, want) {
+			t.Errorf("NewWithMode(%d, %d, %v) = %v, want %v", val.sig, val.exp, val.mode, res, want)
+		}
+	}
+}
+
+func TestNextAfter(t *testing.T) {
+	t.Parallel()
+
+	one := FromInt64(1)
+	two := FromInt64(2)
+
+	if res := NextAfter(one, two); !resultEqual(res, NextUp(one)) {
+		t.Errorf("NextAfter(1, 2) = %v, want %v", res, NextUp(one))
+	}
+
+	if res := NextAfter(one, zero(true)); !resultEqual(res, NextDown(one)) {
+		t.Errorf("NextAfter(1, -0) = %v, want %v", res, NextDown(one))
+	}
+
+	if res := NextAfter(zero(false), zero(true)); !resultEqual(res, zero(true)) {
+		t.Errorf("NextAfter(0, -0) = %v, want -0", res)
+	}
+
+	if res := NextAfter(inf(false), one); !resultEqual(res, NextDown(inf(false))) {
+		t.Errorf("NextAfter(Inf, 1) = %v, want %v", res, NextDown(inf(false)))
+	}
+
+	if res := NextAfter(one, NaN()); !res.IsNaN() {
+		t.Errorf("NextAfter(1, NaN) = %v, want NaN", res)
+	}
+
+	if res := NextAfter(SignalingNaN(0), one); !res.IsNaN() || res.IsSignalingNaN() {
+		t.Errorf("NextAfter(sNaN, 1) = %v, want NaN", res)
+	}
+}
+
+func TestNextDown(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var res Decimal
+
+	for r.scan("nextdown(%v) = %v\n", &val, &res) {
+		nxt := NextDown(val)
+
+		if !resultEqual(nxt, res) {
+			t.Errorf("NextDown(%v) = %v, want %v", val, nxt, res)
+		}
+	}
+}
+
+func TestNextUp(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var res Decimal
+
+	for r.scan("nextup(%v) = %v\n", &val, &res) {
+		nxt := NextUp(val)
+
+		if !resultEqual(nxt, res) {
+			t.Errorf("NextUp(%v) = %v, want %v", val, nxt, res)
+		}
+	}
+}
+
+func TestScaleb(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var n int
+	var res testDataResult
+
+	for r.scan("scaleb(%v, %d) = %v\n", &val, &n, &res) {
+		for _, mode := range roundingModes {
+			scl := ScalebWithMode(val, n, mode)
+
+			if !res.equal(scl, mode) {
+				t.Errorf("ScalebWithMode(%v, %d, %v) = %v, want %v", val, n, mode, scl, res.result(mode))
+			}
+		}
+	}
+}
+
+func TestSignalingNaN(t *testing.T) {
+	t.Parallel()
+
+	dec := SignalingNaN(42)
+
+	if !dec.IsNaN() {
+		t.Errorf("%v.IsNaN() = false, want true", dec)
+	}
+
+	if !dec.IsSignalingNaN() {
+		t.Errorf("%v.IsSignalingNaN() = false, want true", dec)
+	}
+
+	if p := dec.Payload(); p != 42 {
+		t.Errorf("%v.Payload() = %d, want 42", dec, uint64(p))
+	}
+
+	if s := dec.String(); s != "sNaN" {
+		t.Errorf("%#v.String() = %s, want sNaN", dec, s)
+	}
+
+	if s := fmt.Sprintf("%+8g", dec); s != "   +sNaN" {
+		t.Errorf("fmt.Sprintf(\"%%+8g\", %#v) = %q, want \"   +sNaN\"", dec, s)
+	}
+
+	if NaN().IsSignalingNaN() {
+		t.Errorf("NaN().IsSignalingNaN() = true, want false")
+	}
+
+	if can := dec.Canonical(); can != (Decimal{0, 0x7e00_0000_0000_0000}) {
+		t.Errorf("%v.Canonical() = %#v, want sNaN", dec, can)
+	}
+
+	if can := NaN().Canonical(); can != (Decimal{0, 0x7c00_0000_0000_0000}) {
+		t.Errorf("NaN().Canonical() = %#v, want NaN", can)
+	}
+
+	one := FromInt64(1)
+	results := map[string]Decimal{
+		"Add":      dec.Add(one),
+		"Sub":      one.Sub(dec),
+		"Mul":      dec.Mul(one),
+		"Quo":      one.Quo(dec),
+		"Pow":      dec.Pow(zero(false)),
+		"FMA":      FMA(one, one, dec),
+		"Exp":      Exp(dec),
+		"Log":      Log(dec),
+		"Sqrt":     Sqrt(dec),
+		"Cbrt":     Cbrt(dec),
+		"Quantize": one.Quantize(dec, ToNearestEven),
+	}
+
+	for name, res := range results {
+		if !res.IsNaN() || res.IsSignalingNaN() || res.Payload() != 42 {
+			t.Errorf("%s(sNaN(42)) = %#v, want NaN(42)", name, res)
+		}
+	}
+}
+
 func TestSize(t *testing.T) {
 	t.Parallel()
 
@@ -732,6 +1141,24 @@ func TestSize(t *testing.T) {
 	}
 }
 
+func TestUlp(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var res Decimal
+
+	for r.scan("ulp(%v) = %v\n", &val, &res) {
+		nxt := Ulp(val)
+
+		if !resultEqual(nxt, res) {
+			t.Errorf("Ulp(%v) = %v, want %v", val, nxt, res)
+		}
+	}
+}
+
 func FuzzDecimal(f *testing.F) {
 	f.Add(uint64(0), uint64(0))
 	f.Add(uint64(math.MaxUint64), uint64(math.MaxUint64))
@@ -33,6 +33,37 @@ var (
 		exp: -57,
 	}
 
+	atanEighths = [...]uint192{
+		{0xb4a9_9c5c_7652_2a3b, 0x9dce_5a6d_6b57_8ec7, 0x0512_53a4_152f_edfa}, // atan(1/8)
+		{0xe0e6_3dc2_4b77_6637, 0x2325_0c00_f7a6_869f, 0x09fd_b253_5600_af8a}, // atan(2/8)
+		{0x3fd0_86b1_5201_8038, 0xd41c_0021_5d5a_feeb, 0x0ea1_bd96_25de_055d}, // atan(3/8)
+		{0xf4cd_d277_217c_a082, 0x1731_2abb_3dfa_c97f, 0x12e8_b4f7_9e16_7db2}, // atan(4/8)
+		{0x1e9f_f318_a287_2875, 0x4f22_00d9_58ba_33a0, 0x16c8_0c89_817a_812a}, // atan(5/8)
+		{0xafd7_23a5_f16e_4391, 0x62fc_62f3_1fbb_9e6f, 0x1a3e_770d_f2eb_161e}, // atan(6/8)
+		{0xbb0c_89db_64a7_8b66, 0x7404_9535_f151_fc24, 0x1d50_ef74_9e8c_852a}, // atan(7/8)
+		{0xccb9_644a_1a33_c24b, 0x48af_5c34_cdd8_98b7, 0x2007_f07e_978c_08c1}, // atan(8/8)
+	}
+
+	halfPi = decomposed192{
+		sig: uint192{0x9972_c894_3467_8497, 0x915e_b869_9bb1_316f, 0x400f_e0fd_2f18_1182},
+		exp: -57,
+	}
+
+	eulerGamma = decomposed192{
+		sig: uint192{0xabaa_a4e3_8ccd_aa5a, 0xde06_3825_6f12_fa06, 0xeb68_2097_b56e_c98d},
+		exp: -58,
+	}
+
+	halfLn2Pi = decomposed192{
+		sig: uint192{0x5dd3_26f1_a95d_a051, 0x048b_bf40_391d_db5d, 0x257a_2a7b_7793_735f},
+		exp: -57,
+	}
+
+	invSqrtPi = decomposed192{
+		sig: uint192{0x5c29_142a_3b0e_b579, 0x0bd6_eee4_d68c_fe67, 0xe618_2416_4ae9_44b1},
+		exp: -58,
+	}
+
 	ln = [...]uint192{
 		{0xce06_052e_ed85_0b11, 0xf432_4af7_5d64_cfcb, 0x03e3_15af_624a_52e7}, // ln(1.1)
 		{0xb352_8e25_962a_8d07, 0xa21f_990f_44a0_1c4d, 0x076f_869f_7595_b691}, // ln(1.2)
@@ -124,6 +155,162 @@ var (
 		{0x013e_f59d_a6e5_7f9e, 0x5702_3585_2292_b489, 0x5d15_2f18_6a50_ea18}, // ln(9.8)
 		{0x21a7_05d2_3087_56bf, 0x64f1_d488_c7f4_e235, 0x5d7f_2dfe_dc09_fcc2}, // ln(9.9)
 	}
+
+	// stirling contains the magnitudes of the coefficients B2k / (2k(2k - 1))
+	// of the Stirling series for the logarithm of the gamma function.
+	stirling = [...]decomposed192{
+		{sig: uint192{0x6855_5555_5555_5555, 0xef53_7ec6_6fe9_0b6d, 0x21fc_67ec_d1d5_c9de}, exp: -58}, // B2 / 2
+		{sig: uint192{0x0671_c71c_71c7_1c72, 0xc86b_a695_7508_d0c2, 0x7149_5a6a_bb73_4b91}, exp: -60}, // B4 / 12
+		{sig: uint192{0x2669_a69a_69a6_9a6a, 0x14b1_0b06_2170_3ba5, 0x205e_19d5_5a20_f105}, exp: -60}, // B6 / 30
+		{sig: uint192{0xa018_6186_1861_8618, 0x1b2f_d2ad_fac9_bf56, 0xf2c1_c1c0_23f7_0fa6}, exp: -61}, // B8 / 56
+		{sig: uint192{0x9558_c7f9_1ab8_753a, 0xa194_fc2d_4a40_bb61, 0x2254_49f1_cc32_73fd}, exp: -60}, // B10 / 90
+		{sig: uint192{0x466e_4112_9ccc_b586, 0xb592_b01e_0764_3304, 0x4e33_ea46_9bff_07aa}, exp: -60}, // B12 / 132
+		{sig: uint192{0xc669_0690_6906_9069, 0x2e40_3a22_7d78_302c, 0x1a24_9eb6_2b41_fdbf}, exp: -59}, // B14 / 182
+		{sig: uint192{0x480f_7a24_cf7a_24cf, 0x411b_c854_c18c_7714, 0x7884_5268_a8d8_3e8c}, exp: -59}, // B16 / 240
+		{sig: uint192{0xffb2_fc8e_a105_8a23, 0x75e3_bfb2_44d8_90f0, 0x4943_bf63_6a4c_1f55}, exp: -58}, // B18 / 306
+		{sig: uint192{0x81be_922b_4773_8c0b, 0x2f68_8394_4940_2bbc, 0x38c9_abb0_c754_796a}, exp: -57}, // B20 / 380
+		{sig: uint192{0xbcb9_4951_ccc3_c11b, 0x2791_5c73_574a_26a9, 0x36a9_3e34_0117_eb70}, exp: -56}, // B22 / 462
+		{sig: uint192{0x3ee6_9b9b_cfff_c856, 0x29bb_8d43_666d_8875, 0x3ff7_b999_90ff_8058}, exp: -55}, // B24 / 552
+		{sig: uint192{0xade5_5555_5555_5555, 0xb624_3539_dece_6f1c, 0x5971_1131_e634_6596}, exp: -54}, // B26 / 650
+		{sig: uint192{0x3f57_84a1_bae7_b268, 0x4d2e_bb1a_6b27_720d, 0x9343_50f4_0342_f78b}, exp: -53}, // B28 / 756
+		{sig: uint192{0xbcd4_acfe_e69b_ce77, 0x2035_1096_4af1_386b, 0x1c33_4ead_9e11_5869}, exp: -51}, // B30 / 870
+		{sig: uint192{0x5c9d_f0d4_7158_9261, 0x691e_0674_8a04_0c54, 0x3e25_7213_d7a9_7ab0}, exp: -50}, // B32 / 992
+		{sig: uint192{0x1adc_d2bf_5a81_4afd, 0x15ee_a48b_3873_1275, 0x9c28_b428_6a02_4dcc}, exp: -49}, // B34 / 1122
+		{sig: uint192{0x9148_b6ea_863d_b5dc, 0xbc85_17da_d6c9_aaee, 0x2c61_9da9_a1fc_1a40}, exp: -47}, // B36 / 1260
+		{sig: uint192{0xde82_e9a8_5c40_939b, 0x990f_0714_66d0_de6d, 0x8da5_edf9_b7f2_5a22}, exp: -46}, // B38 / 1406
+		{sig: uint192{0xf987_bde0_37bb_f65b, 0xed07_37d0_680b_0215, 0x3272_779b_be62_e2ac}, exp: -44}, // B40 / 1560
+		{sig: uint192{0xdbe7_075a_b044_5056, 0x2c6a_a072_55fe_666c, 0xc757_dae7_268d_33d2}, exp: -43}, // B42 / 1722
+		{sig: uint192{0xd5ee_ae15_8182_8b9b, 0xcde7_e12d_c114_2e8d, 0x56f3_779f_2f1b_66e3}, exp: -41}, // B44 / 1892
+		{sig: uint192{0x862f_f615_f6b8_6272, 0x2448_6f2c_34ea_9667, 0x29ab_d4f4_464f_f08d}, exp: -39}, // B46 / 2070
+		{sig: uint192{0x8656_e8d1_f114_4b43, 0x0093_34d2_41ea_31d3, 0xda7f_6722_9808_24ed}, exp: -38}, // B48 / 2256
+		{sig: uint192{0xb464_afc0_8bf3_f7c4, 0xc33e_7f94_b02f_7c1f, 0x7cdc_5e57_257c_9326}, exp: -36}, // B50 / 2450
+		{sig: uint192{0xed91_63f6_1df1_aad3, 0x721b_d4d5_2869_75f2, 0x4d7c_d6e1_12e4_e9a5}, exp: -34}, // B52 / 2652
+		{sig: uint192{0xf99e_7a7a_4b6a_5d38, 0x4403_d81b_14c1_81c1, 0x340d_9591_e718_4aa1}, exp: -32}, // B54 / 2862
+		{sig: uint192{0x1812_bc1e_8ee4_727e, 0xb756_2260_7c95_87e7, 0x25bc_6c57_0b9f_3b09}, exp: -30}, // B56 / 3080
+		{sig: uint192{0x5357_5bba_c4ce_3dc3, 0x2afc_c207_2a4a_ea35, 0x1d70_cd6d_96d6_0945}, exp: -28}, // B58 / 3306
+		{sig: uint192{0x690f_465f_ad42_992e, 0xcd1d_ebcc_1fe7_cd06, 0xf68a_a8df_a2fb_919f}, exp: -27}, // B60 / 3540
+	}
+
+	// zetaMinus1 contains the values of ζ(k) - 1 for k from 2 to 30.
+	zetaMinus1 = [...]decomposed192{
+		{sig: uint192{0x7e4d_f1b0_f337_8d68, 0x911b_377b_70ac_9e0b, 0x1a4d_6d03_a325_b743}, exp: -57}, // ζ(2) - 1
+		{sig: uint192{0xefc3_b584_cf1e_a203, 0xaaa4_5a5c_d5ea_0cf1, 0x5267_b99a_19b5_ae1d}, exp: -58}, // ζ(3) - 1
+		{sig: uint192{0x5995_f611_fe17_475e, 0x5041_8348_9064_2097, 0x2192_f256_be16_b4f8}, exp: -58}, // ζ(4) - 1
+		{sig: uint192{0x466d_c148_2e08_516b, 0x8a17_df5c_6d63_6a7d, 0x969a_6013_b716_db64}, exp: -59}, // ζ(5) - 1
+		{sig: uint192{0x8bc1_94c2_36b5_82b1, 0x210d_9161_b332_d58d, 0x46bb_0067_58bb_d218}, exp: -59}, // ζ(6) - 1
+		{sig: uint192{0x150a_38ba_0ccd_1e40, 0x7b9c_99ff_2579_8e49, 0x220d_0d64_e4e1_3c92}, exp: -59}, // ζ(7) - 1
+		{sig: uint192{0x9771_10aa_e16d_7696, 0x16bf_2193_7ff1_7aed, 0xa649_95c1_9f95_af1d}, exp: -60}, // ζ(8) - 1
+		{sig: uint192{0xbbbc_6060_6c5e_d3f5, 0x23c6_e8d2_1666_50b3, 0x51e8_9978_34bf_9137}, exp: -60}, // ζ(9) - 1
+		{sig: uint192{0xb609_8e3d_decd_dc3d, 0x6f73_3b5a_5c9c_cd93, 0x288f_d94d_9c64_8844}, exp: -60}, // ζ(10) - 1
+		{sig: uint192{0x714f_b402_1606_e876, 0x3167_8850_5dd0_cbc0, 0xc98b_b29c_b949_cf22}, exp: -61}, // ζ(11) - 1
+		{sig: uint192{0x9574_81c3_95ac_c3ea, 0x025e_547a_9870_491a, 0x645c_a28d_97f1_b341}, exp: -61}, // ζ(12) - 1
+		{sig: uint192{0x2ea4_5792_f500_5473, 0xf2b3_45e4_de2a_fa33, 0x320b_df0c_745e_3139}, exp: -61}, // ζ(13) - 1
+		{sig: uint192{0x4d48_ca44_ef27_f540, 0x1286_8031_973e_e586, 0xf9ca_0964_f686_9d34}, exp: -62}, // ζ(14) - 1
+		{sig: uint192{0x9f2b_873e_0467_97e1, 0x94ed_b044_8d4c_3186, 0x7cbf_9bda_c2e7_c7c3}, exp: -62}, // ζ(15) - 1
+		{sig: uint192{0x66a9_5f16_91eb_a9b8, 0xf56a_6845_09a4_d225, 0x3e53_6c5d_2177_8923}, exp: -62}, // ζ(16) - 1
+		{sig: uint192{0xd5ba_1d8a_999f_40c0, 0x1f97_6706_1048_dcb2, 0x1f25_9b3b_a9ac_fbae}, exp: -62}, // ζ(17) - 1
+		{sig: uint192{0x941d_a829_e631_691e, 0x9857_8d4e_6bc8_9e8e, 0x9bae_66ba_0d37_c31e}, exp: -63}, // ζ(18) - 1
+		{sig: uint192{0x28dc_ba21_8343_8977, 0xcf7f_727a_699c_f48f, 0x4dd2_ab9b_b003_e7df}, exp: -63}, // ζ(19) - 1
+		{sig: uint192{0x7e09_808b_7f5b_922a, 0x1b07_2535_3867_df26, 0x26e7_d40f_1444_1b2f}, exp: -63}, // ζ(20) - 1
+		{sig: uint192{0x8c35_3aea_6b35_e8fa, 0x8685_8bd4_5b73_c3ac, 0xc282_208f_c880_7822}, exp: -64}, // ζ(21) - 1
+		{sig: uint192{0x9756_bfd6_3611_b01f, 0xfe8f_3119_d20f_a8eb, 0x613f_64e3_5050_e8e4}, exp: -64}, // ζ(22) - 1
+		{sig: uint192{0x8105_1436_847e_b082, 0x257a_00e6_c562_9f7b, 0x309f_241b_cd22_d02b}, exp: -64}, // ζ(23) - 1
+		{sig: uint192{0x241a_f27d_4c7d_6779, 0x7699_ce00_7f38_e1c7, 0xf319_da69_b1ea_81c6}, exp: -65}, // ζ(24) - 1
+		{sig: uint192{0x5c3a_4308_24e0_66e6, 0xc8af_efa3_eb26_432c, 0x798c_4f3e_3c44_e417}, exp: -65}, // ζ(25) - 1
+		{sig: uint192{0x45b9_4426_d2e0_e82d, 0x622b_1f5e_0b91_3ad0, 0x3cc5_f2fc_b0ff_fc27}, exp: -65}, // ζ(26) - 1
+		{sig: uint192{0x4f89_5204_ff8b_1d1c, 0xf9dc_c935_63ee_ca21, 0x1e62_e7f4_254a_3a9a}, exp: -65}, // ζ(27) - 1
+		{sig: uint192{0x4fc3_5b24_7621_eaf7, 0xe151_3cb6_9962_89e1, 0x97ee_4d50_9421_c197}, exp: -66}, // ζ(28) - 1
+		{sig: uint192{0xdafe_852a_05c8_0b2e, 0x5b00_8c09_c67b_c894, 0x4bf7_132d_08d0_c40d}, exp: -66}, // ζ(29) - 1
+		{sig: uint192{0x6291_f148_f436_e1e5, 0x5e9a_58df_8ddc_e6b1, 0x25fb_8318_4c28_3024}, exp: -66}, // ζ(30) - 1
+	}
+
+	// twoOverPi contains the digits of 2/π after the decimal point, in base
+	// 10**19 with the most significant digit first.
+	twoOverPi = [...]uint64{
+		0x5859_4719_a198_e636, 0x68d3_7118_4080_1aad, 0x6cc8_3dac_560e_4bae, 0x5ce5_0565_c8a0_7659,
+		0x0885_7e87_b492_3645, 0x2086_efc6_5f18_3597, 0x737b_d213_3776_7367, 0x1447_0d43_5625_a447,
+		0x81da_7266_61be_c504, 0x2b0f_cc20_e04f_5794, 0x2aae_5317_c8c7_53af, 0x08f9_cd06_8f3c_46ee,
+		0x479d_af1e_e18e_cf4b, 0x896b_fcfe_9cae_b742, 0x699e_9f87_77cb_b70b, 0x67c5_7726_77cf_d6fb,
+		0x8a7b_073d_807b_e68b, 0x0d4a_ecfd_5697_5a63, 0x125c_3a13_aa81_12d0, 0x1a16_5175_cd89_ecf5,
+		0x7eee_1228_2aeb_fa4f, 0x44e9_4331_95db_db63, 0x8656_370c_ca28_4dd4, 0x4170_95fd_2cef_9ebc,
+		0x591f_095f_fa4d_2885, 0x5045_6a0d_c111_0497, 0x640e_fcc7_562c_f5bc, 0x5707_2394_f539_0288,
+		0x5b05_c63f_b056_95ef, 0x7478_badc_a4b8_d48c, 0x13fb_a47b_9009_ceb1, 0x2b39_8061_a16d_894c,
+		0x00e6_cb3d_a48c_57c6, 0x2795_e2b4_bc2a_9485, 0x7de8_2d29_9175_4a1d, 0x1f88_6cf9_bee7_b185,
+		0x22a7_7474_a8de_cb18, 0x8801_0466_db08_3da6, 0x69a0_5e60_e614_5168, 0x090e_e424_5c7d_9dd0,
+		0x22a4_5f08_3e64_a861, 0x5838_9436_b7c4_ff2e, 0x29dc_0ee9_dd54_8008, 0x25c2_882f_c642_b98d,
+		0x739d_44ab_f162_6d5f, 0x4579_a45b_f375_7ca6, 0x7c65_9d0a_88a8_ab13, 0x43ab_9c3b_433a_e526,
+		0x7361_2b32_2b1d_8110, 0x3064_16c0_0deb_7f5c, 0x5c4a_320b_4669_8e3d, 0x0a5a_53ff_73da_bafe,
+		0x11d3_7990_7aef_22aa, 0x0216_b0fe_307e_7ef3, 0x7076_d912_1b45_af49, 0x2e50_5282_1003_4a5f,
+		0x3b03_c0e1_3ba4_2798, 0x26b7_a3a0_cab1_4add, 0x3d5b_6d7d_2412_799f, 0x722b_bb14_3702_6239,
+		0x64d2_4bea_cb0f_3629, 0x63cf_1358_46ef_2ffb, 0x03ab_c185_cc45_8d78, 0x1c31_0624_76fc_5393,
+		0x4f5f_e38f_1933_2947, 0x7a16_097f_3a27_606a, 0x6a85_4b4a_9c94_5ee2, 0x41ec_0113_a656_7362,
+		0x45ae_55c8_b749_834b, 0x48c7_661c_456d_cec1, 0x1233_1126_7561_ce4e, 0x3b70_d8bb_381f_738b,
+		0x88b1_897f_49ea_f06b, 0x7a41_68db_89e0_8691, 0x8152_3142_e1ea_ec57, 0x0278_4e4d_b115_fb87,
+		0x60a4_5faf_6f99_fe76, 0x5b92_46cf_8c06_9520, 0x0221_cb7b_d2ff_df4f, 0x4c66_abb4_95ce_1be1,
+		0x7e65_b31b_2d71_f128, 0x3a2a_1ac0_aa41_9616, 0x2e15_af04_d0e7_8ec2, 0x3e70_94f6_0cbf_6a30,
+		0x799d_a6e4_72c9_569d, 0x1671_c714_881b_6182, 0x683c_9124_11fd_ed09, 0x3318_4c13_8b37_7f37,
+		0x6278_3363_fa33_17c7, 0x1247_746f_531c_aa3c, 0x7fce_aa92_56c5_03cc, 0x6773_d5b2_b746_e038,
+		0x7f3a_c50f_1517_db9d, 0x2d29_0702_2ab7_98e9, 0x86d6_8bd2_0522_66e3, 0x5884_7675_b21a_ba37,
+		0x1ecb_3477_5a64_4fbf, 0x09c5_91b7_2432_6593, 0x7ce3_e659_b2af_5b53, 0x8524_6141_d4fb_9ddb,
+		0x1bf9_f7ba_b18d_c389, 0x536b_34ac_954b_519b, 0x150d_4360_50d2_2d04, 0x1225_8656_0530_eaad,
+		0x8662_0c3f_5e74_459c, 0x13b5_80ad_0d22_e1ab, 0x17ea_af6c_d19e_dcf2, 0x15ca_8f24_cf0c_d070,
+		0x3744_a429_d859_bf10, 0x7469_4a42_01a2_8b97, 0x48a5_8c2c_afcb_9757, 0x787a_e153_c932_6f60,
+		0x6ade_84db_38b1_a1b0, 0x3fd0_52c4_a93f_dc30, 0x4dad_6e6b_7dd5_a64b, 0x1099_3053_f630_f9a7,
+		0x2ae8_d1a1_20da_2ea6, 0x1fe3_7c9a_4c91_30f2, 0x1814_aa9e_d622_7124, 0x89d4_dd53_5840_3034,
+		0x7e20_843b_de49_21ae, 0x80ac_6b50_0dae_e9be, 0x0612_4bfa_d8bd_c1e7, 0x17a6_d82e_869b_1973,
+		0x0992_de8d_7bc8_5d84, 0x87e5_b963_f4e4_1e6b, 0x5d71_8b13_e497_4181, 0x3c55_4de3_c1ef_7994,
+		0x4e3e_405c_7208_7afc, 0x3f37_6a6a_60a4_cd79, 0x0e6b_0ca0_e53c_9677, 0x1dd0_2851_1eee_65ab,
+		0x83f8_2bb3_682b_9f8d, 0x0b09_d689_d72e_0b91, 0x37f8_8b27_5864_ac80, 0x5939_3f8a_3c14_c5f8,
+		0x6d8b_5b80_1c32_7596, 0x34f7_51aa_a4f4_bc23, 0x3f86_f4e9_958f_95bf, 0x7e1a_046d_e7b6_4aff,
+		0x46c0_986b_ae32_78e7, 0x3beb_162c_ca20_84d4, 0x3dcc_f151_24eb_8924, 0x27f5_b18c_c7ae_32a6,
+		0x0aa8_2876_e649_f79f, 0x617d_645f_7837_7b66, 0x6462_4218_dd42_2f53, 0x2243_31db_048f_e843,
+		0x388d_4ed1_7c76_3a3e, 0x58b9_4bb9_5f45_e282, 0x3868_57f6_c6a4_4d68, 0x3d73_c4f3_7d6f_afb9,
+		0x4bde_7e12_79bf_1f0e, 0x6115_d096_d7b3_b01e, 0x857e_1b65_0260_2c84, 0x801c_7ff0_fd65_3bc9,
+		0x56cc_a434_8b65_e144, 0x2922_5335_9576_186e, 0x7b37_8cf1_7909_a2c4, 0x00ed_4328_1158_d9a0,
+		0x6836_f63d_d95c_d596, 0x56ed_cc96_babd_077c, 0x3720_c226_e53d_a5ca, 0x6a89_27c7_0214_2e8e,
+		0x1f72_8b5c_a430_38e0, 0x3802_249c_25cb_2368, 0x273c_0318_a170_d5ab, 0x1c24_d439_0b2f_1ff6,
+		0x4552_96b2_b7cb_dff7, 0x7a92_c829_9a8b_8a6a, 0x2407_555f_41d0_95e7, 0x4bbc_ad3c_003f_a53b,
+		0x7252_e404_56c8_2554, 0x2577_1be3_0350_0d49, 0x1d99_6ac6_41e2_1b4b, 0x6c9f_7369_d4a5_e864,
+		0x1e30_56f0_954d_8078, 0x1366_78b7_ac0a_f54b, 0x3d33_2662_f866_bf48, 0x247d_69ff_2b6f_d3b1,
+		0x1096_5558_e8fb_b134, 0x7e38_13a6_a936_6d4e, 0x7007_ce74_9a49_63d1, 0x7d52_d65d_6011_4fbe,
+		0x85c4_9385_d65b_a323, 0x8a71_24b2_98a4_de68, 0x67f4_304a_e25b_422e, 0x2e3a_1e76_ad29_1297,
+		0x4d49_8e2b_13fc_c2b5, 0x1252_abfc_bda8_ae77, 0x7b65_9736_0d3c_3338, 0x5aef_fb73_621c_cee1,
+		0x36b0_18fb_d861_cad7, 0x620b_c892_eb64_fb93, 0x8778_3372_53fd_168d, 0x3dd2_4b52_9f53_bd48,
+		0x5c2f_0833_9d8f_74aa, 0x0366_1349_1b7a_dd7a, 0x4a16_3617_c2ec_9ce9, 0x0d7c_9c78_dc6d_2e99,
+		0x7fe3_449c_2a5c_5752, 0x5f82_0c1d_e8a3_5425, 0x178b_03b5_28f8_5f8c, 0x8a84_9b67_aab5_b669,
+		0x6b9b_49c9_ab8d_dc7b, 0x20ab_d73b_bd0e_ccaf, 0x5b0b_34ee_1fd7_c0b5, 0x81b5_d345_dbff_935d,
+		0x023a_6ded_85f4_9674, 0x7a60_283a_51ea_5c35, 0x48fe_6d44_0220_eff0, 0x8051_bc48_65ab_5dca,
+		0x3e75_84f0_cee9_eaed, 0x2903_7625_d00a_526f, 0x2425_c197_0f3b_c78e, 0x2d86_7f70_f70d_81be,
+		0x12e2_5a94_1b33_f82d, 0x520c_31e4_a02c_4e13, 0x5d6c_2f85_e820_d5a7, 0x7157_6bf9_c41f_6638,
+		0x2839_450c_d725_4531, 0x1510_913f_180f_d7d6, 0x7752_b852_463d_3532, 0x339d_5b10_1a27_de12,
+		0x6983_0b2b_080d_2329, 0x2f35_b42f_1231_7059, 0x1f33_317f_f5f9_5295, 0x52d3_00fd_0f89_4fb9,
+		0x5b44_0d81_60c2_d7a5, 0x77da_ea01_cd18_4d4c, 0x5c7d_820f_e737_9659, 0x3075_6f4a_a4f7_a944,
+		0x5aee_ae61_b05b_0574, 0x6f06_e4ae_1899_9195, 0x10d5_9edd_458d_45e2, 0x495d_f01c_8fc4_5f3c,
+		0x6ea6_14d8_1064_d360, 0x5889_bf14_a4c0_18e3, 0x0551_e527_8625_dcde, 0x2f2c_cee1_afc2_9a49,
+		0x4b39_ac80_d3e7_e21e, 0x4d94_62d7_e6d6_8bf2, 0x72f6_e9de_d73a_45e1, 0x20d7_f99c_d1ac_0cc5,
+		0x2fc3_85fd_3a72_f43e, 0x3f18_84a0_60bc_a013, 0x7afe_7880_3ba4_dd87, 0x1899_4bd6_1577_1035,
+		0x2759_ef7a_7801_64b1, 0x84ba_bc80_fd0c_e9a6, 0x7555_c74e_a386_61cd, 0x56f4_5414_c868_dadf,
+		0x0ac9_fde1_c622_74c0, 0x62b7_31cd_6bfa_0f89, 0x86a0_b8fd_2f3d_9eb0, 0x0c15_3d3e_d462_fb79,
+		0x0c8d_0384_2515_dc37, 0x4509_086d_2eea_65b6, 0x7763_7895_40df_8181, 0x39cf_7081_9aa9_bfcf,
+		0x7fe3_2e9c_1212_ce20, 0x0e11_b21a_cb7f_9ef5, 0x6007_5be8_de01_5119, 0x1da1_b443_1e32_8697,
+		0x2252_9ff1_c6dc_c3ec, 0x8214_e45e_c144_fd86, 0x7417_06bd_f113_659c, 0x73ce_c449_9c3d_5ca6,
+		0x2650_1e1d_9ddf_b49c, 0x4ce4_c001_bc4a_18c8, 0x362e_952f_85cb_9d48, 0x4df2_1adc_ba5e_db71,
+		0x11f1_e0b2_381f_36bf, 0x4e98_a96b_9157_e49a, 0x6e8e_e5d6_92a6_d400, 0x21c1_d3de_d69e_66a0,
+		0x85a8_8227_975d_2690, 0x8219_8d86_9d5c_6d58, 0x1d76_6236_9e38_a9d6, 0x5838_15bb_611b_a7f6,
+		0x7677_78f8_c87b_fe38, 0x1615_c134_4c2f_fd08, 0x2ba2_9f55_5a8f_b86f, 0x3333_5924_b9e5_a83f,
+		0x543c_4495_4ad1_cdbb, 0x3568_0369_d691_3b79, 0x4356_2df4_bfc8_f495, 0x37b3_d141_1e98_c71a,
+		0x4894_1e00_a03e_0555, 0x23e9_7de1_421d_8cd9, 0x2b6e_c9bd_dfa4_86c9, 0x6baa_f925_c36b_7e7e,
+		0x52d5_630a_eeea_4198, 0x7970_7f37_a6ba_9295, 0x5402_c77a_503e_bce9, 0x1419_de62_f3f7_b7d1,
+		0x68e7_b350_cb05_8531, 0x2cfa_dc6c_86ff_43ac, 0x7607_3164_fcef_dd06, 0x759c_0249_634e_9cbc,
+		0x02f8_8056_d141_d49d, 0x5846_693a_ddcf_f7e4, 0x763a_21ea_e5c9_5395, 0x0496_fc3b_5482_d73c,
+		0x3768_2052_7775_52ac, 0x8522_b79f_db95_926a, 0x49c4_595c_9b27_363d, 0x0200_8a71_9eb1_e804,
+		0x6c30_a4ed_2f23_2dfc, 0x24f7_d67f_5e1e_5cb0, 0x67e8_2fc6_ee36_6edf, 0x6cd6_7c95_ae60_7122,
+		0x3c76_a334_ca55_b4e9, 0x779f_cf25_bd8e_85ee, 0x4f92_c75d_3ad9_be56, 0x1974_f75e_ba88_bfca,
+		0x4931_0910_4d6d_64e2, 0x06c0_8c50_1da3_3c64, 0x6efa_a007_a8c9_277b, 0x6d58_c122_9cf0_5d0b,
+		0x183e_1108_7ea3_d8cc, 0x7403_f33a_485f_222c, 0x4101_c07e_a7d1_7e13, 0x6c91_3cac_4f95_1d86,
+		0x112c_1025_ca35_20a9, 0x110f_5551_697f_73ee, 0x755e_782f_c38c_aed2, 0x2365_4515_b6ba_4d42,
+		0x3b7e_892f_9aa8_aee2, 0x75d1_7d26_8f9a_b056, 0x4960_47fb_091a_56d9, 0x02f0_2ba2_508a_b9c0,
+	}
 )
 
 type decomposed192 struct {
@@ -451,6 +638,108 @@ func (d decomposed192) add1neg(trunc int8) (bool, decomposed192, int8) {
 	}, trunc
 }
 
+func (d decomposed192) atan(trunc int8) (decomposed192, int8) {
+	if d.sig[0]|d.sig[1]|d.sig[2] == 0 {
+		return d, trunc
+	}
+
+	if neg, dif, _ := d.sub1(0); !neg && dif.sig[0]|dif.sig[1]|dif.sig[2] != 0 {
+		// atan(x) = π/2 - atan(1/x)
+		d, trunc = d.rcp(trunc)
+		d, trunc = d.atan(trunc)
+		_, d, trunc = halfPi.sub(d, trunc)
+		return d, trunc
+	}
+
+	// Reduce the argument using atan(x) = atan(c) + atan((x - c) / (1 + xc)),
+	// where c is the nearest multiple of 1/8, so that the series below only
+	// needs to handle values of not much more than 1/16.
+	var k uint64
+	if d.exp >= -3 {
+		k = d.sig[0] * uint192PowersOf10[d.exp+3][0]
+	} else if int(-d.exp-3) < len(uint192PowersOf10) {
+		quo, _ := d.sig.div(uint192PowersOf10[-d.exp-3])
+		k = quo[0]
+	}
+
+	k = (k*8 + 500) / 1000
+
+	var base decomposed192
+	neg := false
+
+	if k != 0 {
+		c := decomposed192{
+			sig: uint192{k * 125, 0, 0},
+			exp: -3,
+		}
+
+		var num decomposed192
+		neg, num, trunc = d.sub(c, trunc)
+
+		den, _ := d.mul(c, int8(0))
+		den, _ = den.add1(int8(0))
+
+		d, trunc = num.quo(den, trunc)
+
+		base = decomposed192{
+			sig: atanEighths[k-1],
+			exp: -57,
+		}
+	}
+
+	sqr, trunc := d.pow2(trunc)
+	res, _ := decomposed192{
+		sig: uint192{1, 0, 0},
+		exp: 0,
+	}.quo(decomposed192{
+		sig: uint192{53, 0, 0},
+		exp: 0,
+	}, int8(0))
+
+	for i := 51; i > 0; i -= 2 {
+		tmp, _ := decomposed192{
+			sig: uint192{1, 0, 0},
+			exp: 0,
+		}.quo(decomposed192{
+			sig: uint192{uint64(i), 0, 0},
+			exp: 0,
+		}, int8(0))
+
+		res, trunc = sqr.mul(res, trunc)
+		_, res, trunc = tmp.sub(res, trunc)
+	}
+
+	res, trunc = res.mul(d, trunc)
+
+	if neg {
+		_, res, trunc = base.sub(res, trunc)
+		return res, trunc
+	}
+
+	return base.add(res, trunc)
+}
+
+func (d decomposed192) cos(trunc int8) (decomposed192, int8) {
+	sqr, trunc := d.pow2(trunc)
+
+	res := decomposed192{
+		sig: uint192{1, 0, 0},
+		exp: 0,
+	}
+
+	for i := uint64(48); i > 0; i -= 2 {
+		tmp, _ := sqr.quo(decomposed192{
+			sig: uint192{i * (i - 1), 0, 0},
+			exp: 0,
+		}, int8(0))
+
+		res, trunc = res.mul(tmp, trunc)
+		_, res, trunc = res.sub1(trunc)
+	}
+
+	return res, trunc
+}
+
 func (d decomposed192) epow(l10 int16, trunc int8) (decomposed192, int8) {
 	exp := d.exp + l10 + 1
 	if exp < 0 {
@@ -538,9 +827,12 @@ func (d decomposed192) epowm1(neg bool, l10 int16, trunc int8) (bool, decomposed
 
 	if exp == 0 {
 		if neg {
-			res, trunc = res.add1(trunc)
-			res, trunc = res.rcp(trunc)
-			return res.sub1(trunc)
+			// e**-x - 1 = -(e**x - 1) / e**x, which keeps the relative
+			// precision of e**x - 1 when x is tiny, unlike subtracting 1
+			// from e**-x.
+			den, _ := res.add1(0)
+			res, trunc = res.quo(den, trunc)
+			return true, res, trunc
 		}
 
 		return false, res, trunc
@@ -556,6 +848,297 @@ func (d decomposed192) epowm1(neg bool, l10 int16, trunc int8) (bool, decomposed
 	return res.sub1(trunc)
 }
 
+// erf returns the error function of the non-negative value d.
+func (d decomposed192) erf() (decomposed192, int8) {
+	if lt, _, _ := d.sub(decomposed192{
+		sig: uint192{6, 0, 0},
+		exp: 0,
+	}, 0); !lt {
+		res, trunc := d.erfc()
+		_, res, trunc = res.sub1(trunc)
+		return res, trunc
+	}
+
+	// erf(x) = 2/√π * e**-x**2 * Σ 2**n * x**(2n+1) / (1 * 3 * ... * (2n+1)),
+	// which avoids the cancellation of the alternating Taylor series.
+	sqr, _ := d.pow2(0)
+	sqr2, _ := sqr.add(sqr, 0)
+
+	res := d
+	tmp := d
+	var trunc int8
+
+	for i := uint64(3); ; i += 2 {
+		tmp, _ = tmp.mul(sqr2, 0)
+		tmp, _ = tmp.quo(decomposed192{
+			sig: uint192{i, 0, 0},
+			exp: 0,
+		}, 0)
+
+		res, trunc = res.add(tmp, trunc)
+
+		if int(tmp.exp)+tmp.sig.log10() < int(res.exp)+res.sig.log10()-58 {
+			break
+		}
+	}
+
+	ex, _ := sqr.epow(int16(sqr.sig.log10()), 0)
+	res, trunc = res.quo(ex, trunc)
+	res, trunc = res.mul(invSqrtPi, trunc)
+	return res.add(res, trunc)
+}
+
+// erfc returns the complementary error function of the non-negative value d.
+func (d decomposed192) erfc() (decomposed192, int8) {
+	if lt, _, _ := d.sub(decomposed192{
+		sig: uint192{6, 0, 0},
+		exp: 0,
+	}, 0); lt {
+		res, trunc := d.erf()
+		_, res, trunc = res.sub1(trunc)
+		return res, trunc
+	}
+
+	// erfc(x) = e**-x**2 / √π * 1/(x + (1/2)/(x + 1/(x + (3/2)/(x + ...)))),
+	// which converges quickly when x is at least 6.
+	t := d
+	var trunc int8
+
+	for i := uint64(120); i > 0; i-- {
+		tmp, _ := decomposed192{
+			sig: uint192{i * 5, 0, 0},
+			exp: -1,
+		}.quo(t, 0)
+
+		t, trunc = d.add(tmp, trunc)
+	}
+
+	sqr, _ := d.pow2(0)
+	ex, _ := sqr.epow(int16(sqr.sig.log10()), 0)
+	ex, _ = ex.mul(t, 0)
+	return invSqrtPi.quo(ex, trunc)
+}
+
+func (d decomposed192) exactPow(val decomposed192, p, q uint64, inv bool) (decomposed192, bool) {
+	// d approximates val**(p/q), or its reciprocal if inv is set. When that
+	// power is exact d is within a tiny distance of it, so round d to the
+	// nearest 34 digit value and check whether raising that to the power of
+	// q gives back val**p exactly.
+	sig, exp := ToNearestEven.reduce192(false, d.sig, d.exp+exponentBias, 0)
+
+	if sig[0]|sig[1] == 0 {
+		return d, false
+	}
+
+	for {
+		tmp, rem := sig.div10()
+		if rem != 0 {
+			break
+		}
+
+		sig = tmp
+		exp++
+	}
+
+	est := decomposed192{
+		sig: uint192{sig[0], sig[1], 0},
+		exp: exp - exponentBias,
+	}
+
+	// Raising a value with n significant digits to the power of q gives at
+	// least (n - 1) * q + 1 digits, which must fit for the power to be exact.
+	if l10 := uint64(est.sig.log10()); l10 != 0 && q > 57/l10 {
+		return d, false
+	}
+
+	lhs, lhsTrunc := est.powInt(q, 0)
+	rhs, rhsTrunc := val.powInt(p, 0)
+
+	if lhsTrunc != 0 || rhsTrunc != 0 {
+		return d, false
+	}
+
+	var diff decomposed192
+	if inv {
+		lhs, lhsTrunc = lhs.mul(rhs, 0)
+		if lhsTrunc != 0 {
+			return d, false
+		}
+
+		_, diff, _ = lhs.sub1(0)
+	} else {
+		_, diff, _ = lhs.sub(rhs, 0)
+	}
+
+	if diff.sig != (uint192{}) {
+		return d, false
+	}
+
+	return est, true
+}
+
+func (d decomposed192) gammaReflect(frc decomposed192) (decomposed192, decomposed192, int8) {
+	// Returns |sin(πx)| and log(Γ(1 - x)) for negative x, where d is |x| and
+	// frc is the fractional part of |x|.
+	_, omf, _ := frc.sub1(0)
+	if lt, _, _ := omf.sub(frc, 0); lt {
+		frc = omf
+	}
+
+	pi, _ := halfPi.add(halfPi, 0)
+
+	var sin decomposed192
+	if lt, _, _ := frc.sub(decomposed192{
+		sig: uint192{25, 0, 0},
+		exp: -2,
+	}, 0); lt {
+		sin, _ = frc.mul(pi, 0)
+		sin, _ = sin.sin(0)
+	} else {
+		_, tmp, _ := decomposed192{
+			sig: uint192{5, 0, 0},
+			exp: -1,
+		}.sub(frc, 0)
+
+		sin, _ = tmp.mul(pi, 0)
+		sin, _ = sin.cos(0)
+	}
+
+	opx, _ := d.add1(0)
+	_, prd, opx := opx.lgammaShift(false)
+	res, trunc := opx.lgamma()
+
+	_, lp, _ := prd.log()
+	_, res, trunc = res.sub(lp, trunc)
+
+	return sin, res, trunc
+}
+
+// lgamma returns the natural logarithm of the gamma function of d using the
+// Stirling series, which is accurate when d is at least 40.
+func (d decomposed192) lgamma() (decomposed192, int8) {
+	_, lnd, _ := d.log()
+
+	// (d - 1/2) * log(d) - d + log(2π)/2
+	_, res, _ := d.sub(decomposed192{
+		sig: uint192{5, 0, 0},
+		exp: -1,
+	}, 0)
+
+	res, trunc := res.mul(lnd, 0)
+	_, res, trunc = res.sub(d, trunc)
+	res, trunc = res.add(halfLn2Pi, trunc)
+
+	// Σ B2k / (2k(2k - 1) * d**(2k - 1))
+	rcp, _ := d.rcp(0)
+	sqr, _ := rcp.pow2(0)
+
+	sum := stirling[len(stirling)-1]
+	for i := len(stirling) - 2; i >= 0; i-- {
+		tmp, _ := sum.mul(sqr, 0)
+		_, sum, _ = stirling[i].sub(tmp, 0)
+	}
+
+	sum, _ = sum.mul(rcp, 0)
+	return res.add(sum, trunc)
+}
+
+func (d decomposed192) lgammaNearOne(two bool) (bool, decomposed192, int8) {
+	// log(Γ(1 + z)) = -γz + Σ (-1)**k * ζ(k) * z**k / k
+	// log(Γ(2 + z)) = (1 - γ)z + Σ (-1)**k * (ζ(k) - 1) * z**k / k
+	var neg bool
+	var z decomposed192
+	if two {
+		neg, z, _ = d.sub(decomposed192{
+			sig: uint192{2, 0, 0},
+			exp: 0,
+		}, 0)
+	} else {
+		neg, z, _ = d.sub1(0)
+	}
+
+	var res decomposed192
+	for i := len(zetaMinus1) - 1; i >= 0; i-- {
+		coeff := zetaMinus1[i]
+		if !two {
+			coeff, _ = coeff.add1(0)
+		}
+
+		coeff, _ = coeff.quo(decomposed192{
+			sig: uint192{uint64(i) + 2, 0, 0},
+			exp: 0,
+		}, 0)
+
+		if i == len(zetaMinus1)-1 {
+			res = coeff
+			continue
+		}
+
+		tmp, _ := res.mul(z, 0)
+		if neg {
+			res, _ = coeff.add(tmp, 0)
+		} else {
+			_, res, _ = coeff.sub(tmp, 0)
+		}
+	}
+
+	sqr, _ := z.pow2(0)
+	res, trunc := res.mul(sqr, 0)
+
+	var lin decomposed192
+	if two {
+		_, lin, _ = eulerGamma.sub1(0)
+	} else {
+		lin = eulerGamma
+	}
+
+	lin, _ = lin.mul(z, 0)
+
+	if neg != two {
+		res, trunc = res.add(lin, trunc)
+		return false, res, trunc
+	}
+
+	return res.sub(lin, trunc)
+}
+
+func (d decomposed192) lgammaShift(neg bool) (bool, decomposed192, decomposed192) {
+	// Γ(x) = Γ(x + n) / (x * (x + 1) * ... * (x + n - 1)), where n is chosen
+	// so that x + n is at least 40 and the Stirling series can be used.
+	forty := decomposed192{
+		sig: uint192{40, 0, 0},
+		exp: 0,
+	}
+
+	prdNeg := false
+	prd := decomposed192{
+		sig: uint192{1, 0, 0},
+		exp: 0,
+	}
+
+	for {
+		if !neg {
+			if lt, _, _ := d.sub(forty, 0); !lt {
+				break
+			}
+		}
+
+		prd, _ = prd.mul(d, 0)
+
+		if neg {
+			prdNeg = !prdNeg
+
+			var lt1 bool
+			lt1, d, _ = d.sub1(0)
+			neg = !lt1
+		} else {
+			d, _ = d.add1(0)
+		}
+	}
+
+	return prdNeg, prd, d
+}
+
 func (d decomposed192) log() (bool, decomposed192, int8) {
 	l10 := int16(d.sig.log10())
 	exp := d.exp + l10
@@ -597,7 +1180,9 @@ func (d decomposed192) log() (bool, decomposed192, int8) {
 
 	res := frc
 
-	for i := uint64(3); i <= 25; i += 2 {
+	// |frc| is below 0.05, so the terms up to frc**41 are needed to keep the
+	// series accurate to the full precision of decomposed192.
+	for i := uint64(3); i <= 41; i += 2 {
 		// res += frc^i / i
 		frc, _ = frc.mul(sqr, int8(0))
 		tmp, _ := frc.quo(decomposed192{
@@ -653,6 +1238,21 @@ func (d decomposed192) log1p(neg bool) (bool, decomposed192, int8) {
 
 	var trunc int8
 	for i := uint64(2); i <= 10; i++ {
+		// Stop once the remaining terms are too small to affect the result,
+		// which also keeps the exponent of num from overflowing when d is
+		// tiny. The first omitted term reduces the magnitude unless d is
+		// negative.
+		if int(num.exp)+num.sig.log10() < -58 {
+			if trunc == 0 {
+				trunc = -1
+				if neg {
+					trunc = 1
+				}
+			}
+
+			break
+		}
+
 		num, trunc = num.mul(d, trunc)
 		tmp, _ := num.quo(decomposed192{
 			sig: uint192{i, 0, 0},
@@ -673,6 +1273,17 @@ func (d decomposed192) log1p(neg bool) (bool, decomposed192, int8) {
 	return neg, res, trunc
 }
 
+func (d decomposed192) log1pPos(trunc int8) (decomposed192, int8) {
+	if int(d.exp)+d.sig.log10() > -10 {
+		d, _ = d.add1(trunc)
+		_, res, trunc := d.log()
+		return res, trunc
+	}
+
+	_, res, trunc := d.log1p(false)
+	return res, trunc
+}
+
 func (d decomposed192) mul(o decomposed192, trunc int8) (decomposed192, int8) {
 	sig384 := d.sig.mul(o.sig)
 	exp := d.exp + o.exp
@@ -787,6 +1398,39 @@ func (d decomposed192) pow2(trunc int8) (decomposed192, int8) {
 	}, trunc
 }
 
+func (d decomposed192) powInt(n uint64, trunc int8) (decomposed192, int8) {
+	res := decomposed192{
+		sig: uint192{1, 0, 0},
+		exp: 0,
+	}
+
+	for {
+		if n&1 != 0 {
+			res, trunc = res.mul(d, trunc)
+		}
+
+		n >>= 1
+		if n == 0 {
+			return res, trunc
+		}
+
+		// Every remaining factor moves the result further from 1, so once
+		// either value is far outside of the representable range, stop
+		// before the exponent overflows and return that value instead. The
+		// value returned is no longer the exact power, so mark it as
+		// truncated.
+		if l10 := int(res.exp) + res.sig.log10(); l10 > maxUnbiasedExponent+116 || l10 < minUnbiasedExponent-116 {
+			return res, 1
+		}
+
+		if l10 := int(d.exp) + d.sig.log10(); l10 > maxUnbiasedExponent+116 || l10 < minUnbiasedExponent-116 {
+			return d, 1
+		}
+
+		d, trunc = d.pow2(trunc)
+	}
+}
+
 func (d decomposed192) powexp10(o int16, trunc int8) (decomposed192, int8) {
 	var p10 int64
 	switch o {
@@ -973,6 +1617,63 @@ func (d decomposed192) rcp(trunc int8) (decomposed192, int8) {
 	}, trunc
 }
 
+func (d decomposed192) sin(trunc int8) (decomposed192, int8) {
+	sqr, trunc := d.pow2(trunc)
+
+	res := decomposed192{
+		sig: uint192{1, 0, 0},
+		exp: 0,
+	}
+
+	for i := uint64(48); i > 0; i -= 2 {
+		tmp, _ := sqr.quo(decomposed192{
+			sig: uint192{i * (i + 1), 0, 0},
+			exp: 0,
+		}, int8(0))
+
+		res, trunc = res.mul(tmp, trunc)
+		_, res, trunc = res.sub1(trunc)
+	}
+
+	return res.mul(d, trunc)
+}
+
+func (d decomposed192) sqrt() (decomposed192, int8) {
+	if d.sig[0]|d.sig[1]|d.sig[2] == 0 {
+		return d, 0
+	}
+
+	// Use a floating point estimate of the square root as the starting point
+	// for Newton's method, which doubles the number of correct digits with
+	// each iteration.
+	l10 := d.sig.log10()
+	exp := int(d.exp) + l10
+
+	f := (float64(d.sig[2])*0x1p128 + float64(d.sig[1])*0x1p64 + float64(d.sig[0])) / math.Pow10(l10)
+	if exp%2 != 0 {
+		f *= 10
+		exp--
+	}
+
+	res := decomposed192{
+		sig: uint192{uint64(math.Sqrt(f) * 1e15), 0, 0},
+		exp: int16(exp/2 - 15),
+	}
+
+	half := decomposed192{
+		sig: uint192{5, 0, 0},
+		exp: -1,
+	}
+
+	for range 4 {
+		tmp, _ := d.quo(res, int8(0))
+		res, _ = res.add(tmp, int8(0))
+		res, _ = res.mul(half, int8(0))
+	}
+
+	return res, 1
+}
+
 func (d decomposed192) sub(o decomposed192, trunc int8) (bool, decomposed192, int8) {
 	exp := d.exp - o.exp
 
@@ -1109,19 +1810,22 @@ func (d decomposed192) sub(o decomposed192, trunc int8) (bool, decomposed192, in
 	}, trunc
 }
 
+// sub1 returns the sign and magnitude of d - 1. When d is too small to affect
+// the result, the magnitude is 1 and the returned trunc is negated, as the true
+// magnitude 1 - d lies slightly below 1.
 func (d decomposed192) sub1(trunc int8) (bool, decomposed192, int8) {
 	if d.sig[0]|d.sig[1]|d.sig[2] == 0 {
 		return true, decomposed192{
 			sig: uint192{1, 0, 0},
 			exp: 0,
-		}, trunc
+		}, -trunc
 	}
 
 	if d.exp < -116 {
 		return true, decomposed192{
 			sig: uint192{1, 0, 0},
 			exp: 0,
-		}, 1
+		}, -1
 	}
 
 	if d.exp > 58 {
@@ -1143,7 +1847,7 @@ func (d decomposed192) sub1(trunc int8) (bool, decomposed192, int8) {
 				return true, decomposed192{
 					sig: uint192{1, 0, 0},
 					exp: 0,
-				}, trunc
+				}, -trunc
 			}
 
 			d.exp += 4
@@ -1161,7 +1865,7 @@ func (d decomposed192) sub1(trunc int8) (bool, decomposed192, int8) {
 				return true, decomposed192{
 					sig: uint192{1, 0, 0},
 					exp: 0,
-				}, trunc
+				}, -trunc
 			}
 		}
 
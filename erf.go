@@ -0,0 +1,142 @@
+package decimal128
+
+// Erf returns the error function of d, rounded using the
+// [DefaultRoundingMode].
+func Erf(d Decimal) Decimal {
+	return ErfWithMode(d, DefaultRoundingMode)
+}
+
+// ErfWithMode returns the error function of d, rounded using the provided
+// rounding mode.
+func ErfWithMode(d Decimal, mode RoundingMode) Decimal {
+	neg := d.Signbit()
+
+	if d.isSpecial() {
+		if d.IsNaN() {
+			return d.quiet()
+		}
+
+		return one(neg)
+	}
+
+	dSig, dExp := d.decompose()
+
+	if dSig[0]|dSig[1] == 0 {
+		return d
+	}
+
+	dExp -= exponentBias
+	l10 := int(dExp) + dSig.log10()
+
+	val := decomposed192{
+		sig: uint192{dSig[0], dSig[1], 0},
+		exp: dExp,
+	}
+
+	var res decomposed192
+	var trunc int8
+
+	if l10 < -30 {
+		// erf(x) = 2x/√π - 2x**3/(3√π) + ..., where the second term is too
+		// small to affect the result but always reduces its magnitude.
+		res, trunc = val.mul(invSqrtPi, 0)
+		res, trunc = res.add(res, trunc)
+		if trunc == 0 {
+			trunc = -1
+		}
+	} else if l10 >= 1 {
+		// erf(x) = 1 - erfc(x), where erfc(x) is too small to affect the
+		// result for x of at least 10.
+		sig, exp := mode.reduce128(neg, uint128{1, 0}, exponentBias, -1)
+		return compose(neg, sig, exp)
+	} else {
+		res, trunc = val.erf()
+	}
+
+	sig, exp := mode.reduce192(neg, res.sig, res.exp+exponentBias, trunc)
+	return compose(neg, sig, exp)
+}
+
+// Erfc returns the complementary error function of d, 1 - Erf(d), rounded
+// using the [DefaultRoundingMode]. It is more accurate than 1 - Erf(d) when d
+// is large.
+func Erfc(d Decimal) Decimal {
+	return ErfcWithMode(d, DefaultRoundingMode)
+}
+
+// ErfcWithMode returns the complementary error function of d, 1 - Erf(d),
+// rounded using the provided rounding mode.
+//
+// For 0 < d < 6 the result is computed as 1 - Erf(d), which loses some of the
+// working precision, so its relative error before rounding is below 10**-38
+// rather than the bound described on [RoundingMode].
+func ErfcWithMode(d Decimal, mode RoundingMode) Decimal {
+	neg := d.Signbit()
+
+	if d.isSpecial() {
+		if d.IsNaN() {
+			return d.quiet()
+		}
+
+		if neg {
+			return compose(false, uint128{2, 0}, exponentBias)
+		}
+
+		return zero(false)
+	}
+
+	dSig, dExp := d.decompose()
+
+	if dSig[0]|dSig[1] == 0 {
+		return one(false)
+	}
+
+	dExp -= exponentBias
+	l10 := int(dExp) + dSig.log10()
+
+	if l10 < -60 {
+		// erfc(x) = 1 - 2x/√π + ..., where the second term is too small to
+		// affect the result.
+		var trunc int8 = -1
+		if neg {
+			trunc = 1
+		}
+
+		sig, exp := mode.reduce128(false, uint128{1, 0}, exponentBias, trunc)
+		return compose(false, sig, exp)
+	}
+
+	val := decomposed192{
+		sig: uint192{dSig[0], dSig[1], 0},
+		exp: dExp,
+	}
+
+	var res decomposed192
+	var trunc int8
+
+	if neg {
+		if l10 >= 1 {
+			// erfc(x) = 2 - erfc(-x), where erfc(-x) is too small to affect
+			// the result for x of at most -10.
+			sig, exp := mode.reduce128(false, uint128{2, 0}, exponentBias, -1)
+			return compose(false, sig, exp)
+		}
+
+		res, trunc = val.erf()
+		res, trunc = res.add1(trunc)
+	} else {
+		if lt, _, _ := val.sub(decomposed192{
+			sig: uint192{120, 0, 0},
+			exp: 0,
+		}, 0); !lt {
+			// erfc(x) < e**-x**2, which is too small to be represented for x
+			// of at least 120.
+			return zero(false)
+		}
+
+		res, trunc = val.erfc()
+	}
+
+	sig, exp := mode.reduce192(false, res.sig, res.exp+exponentBias, trunc)
+	return compose(false, sig, exp)
+}
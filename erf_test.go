@@ -0,0 +1,53 @@
+package decimal128
+
+import "testing"
+
+func TestErf(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var res Decimal
+
+	for r.scan("erf(%v) = %v\n", &val, &res) {
+		erf := Erf(val)
+
+		if !resultEqual(erf, res) {
+			t.Errorf("Erf(%v) = %v, want %v", val, erf, res)
+		}
+
+		lo := ErfWithMode(val, ToNegativeInf)
+		hi := ErfWithMode(val, ToPositiveInf)
+
+		if !res.IsNaN() && (lo.Cmp(res).Greater() || hi.Cmp(res).Less()) {
+			t.Errorf("ErfWithMode(%v, ToNegativeInf/ToPositiveInf) = [%v, %v], want interval containing %v", val, lo, hi, res)
+		}
+	}
+}
+
+func TestErfc(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var res Decimal
+
+	for r.scan("erfc(%v) = %v\n", &val, &res) {
+		erfc := Erfc(val)
+
+		if !resultEqual(erfc, res) {
+			t.Errorf("Erfc(%v) = %v, want %v", val, erfc, res)
+		}
+
+		lo := ErfcWithMode(val, ToNegativeInf)
+		hi := ErfcWithMode(val, ToPositiveInf)
+
+		if !res.IsNaN() && (lo.Cmp(res).Greater() || hi.Cmp(res).Less()) {
+			t.Errorf("ErfcWithMode(%v, ToNegativeInf/ToPositiveInf) = [%v, %v], want interval containing %v", val, lo, hi, res)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package decimal128_test
 
 import (
+	"errors"
 	"fmt"
 	"slices"
 
@@ -23,6 +24,46 @@ func ExampleAbs() {
 	// Abs(NaN) = NaN
 }
 
+func ExampleAccumulator() {
+	var acc decimal128.Accumulator
+	var sum decimal128.Decimal
+
+	for _, s := range []string{"1e34", "0.01", "-1e34", "0.02"} {
+		d := decimal128.MustParse(s)
+		acc.Add(d)
+		sum = sum.Add(d)
+	}
+
+	fmt.Println(sum)
+	fmt.Println(acc.Result())
+	// Output:
+	// 0.02
+	// 0.03 true
+}
+
+func ExampleAllocate() {
+	total := decimal128.MustParse("100")
+	ratios := []decimal128.Decimal{
+		decimal128.New(1, 0),
+		decimal128.New(1, 0),
+		decimal128.New(1, 0),
+	}
+
+	fmt.Println(total.Quo(decimal128.New(3, 0)).Round(2, decimal128.ToNearestEven))
+	fmt.Println(decimal128.Allocate(total, ratios, 2))
+	// Output:
+	// 33.33
+	// [33.34 33.33 33.33]
+}
+
+func ExampleAtan2() {
+	fmt.Println(decimal128.Atan2(decimal128.FromInt64(1), decimal128.FromInt64(-1)))
+	fmt.Println(decimal128.Atan2(decimal128.FromInt64(-1), decimal128.FromInt64(0)))
+	// Output:
+	// 2.356194490192344928846982537459627
+	// -1.570796326794896619231321691639751
+}
+
 func ExampleCeil() {
 	x := decimal128.New(-123, -2)
 	y := decimal128.New(789, -2)
@@ -55,6 +96,38 @@ func ExampleCompare() {
 	// [NaN -Inf 1 2 3 +Inf]
 }
 
+func ExampleContext() {
+	var ctx decimal128.Context
+
+	total := decimal128.New(0, 0)
+	for _, amt := range []string{"19.99", "5.01", "0.10"} {
+		total, _ = ctx.Add(total, decimal128.MustParse(amt))
+	}
+
+	fmt.Println(total, ctx.Flags&decimal128.Inexact != 0)
+
+	share, _ := ctx.Quo(total, decimal128.New(3, 0))
+	fmt.Println(share, ctx.Flags&decimal128.Inexact != 0)
+
+	ctx = decimal128.Context{Traps: decimal128.DivisionByZero}
+	_, err := ctx.Quo(total, decimal128.New(0, 0))
+	fmt.Println(errors.Is(err, decimal128.DivisionByZero))
+	// Output:
+	// 25.1 false
+	// 8.366666666666666666666666666666667 true
+	// true
+}
+
+func ExampleFMA() {
+	x := decimal128.MustParse("1.000000000000000001")
+	y := decimal128.New(-1, 0)
+	fmt.Println(x.Mul(x).Add(y))
+	fmt.Println(decimal128.FMA(x, x, y))
+	// Output:
+	// 2e-18
+	// 2.000000000000000001e-18
+}
+
 func ExampleFloor() {
 	x := decimal128.New(-123, -2)
 	y := decimal128.New(789, -2)
@@ -75,6 +148,14 @@ func ExampleFrexp() {
 	// 1.23
 }
 
+func ExampleGamma() {
+	fmt.Println(decimal128.Gamma(decimal128.FromInt64(20)))
+	fmt.Println(decimal128.Gamma(decimal128.New(5, -1)))
+	// Output:
+	// 1.21645100408832e+17
+	// 1.772453850905516027298167483341145
+}
+
 func ExampleNew() {
 	fmt.Println(decimal128.New(3, -2))
 	fmt.Println(decimal128.New(3, 0))
@@ -101,6 +182,28 @@ func ExampleParse() {
 	// NaN
 }
 
+func ExampleParseWithMode() {
+	s := "1.000000000000000000000000000000000000001"
+
+	lo, _ := decimal128.ParseWithMode(s, decimal128.ToNegativeInf)
+	hi, _ := decimal128.ParseWithMode(s, decimal128.ToPositiveInf)
+	fmt.Println(lo)
+	fmt.Println(hi)
+	// Output:
+	// 1
+	// 1.0000000000000000000000000000000001
+}
+
+func ExamplePowInt() {
+	rate := decimal128.MustParse("0.05").Quo(decimal128.FromInt64(12))
+	growth := decimal128.PowInt(decimal128.FromInt64(1).Add(rate), 12)
+	fmt.Println(decimal128.FromInt64(1000).Mul(growth).Round(2, decimal128.ToNearestEven))
+	fmt.Println(decimal128.Root(decimal128.FromInt64(-27), 3))
+	// Output:
+	// 1051.16
+	// -3
+}
+
 func ExampleRound() {
 	x := decimal128.New(-123, -2)
 	y := decimal128.New(789, -2)
@@ -111,6 +214,46 @@ func ExampleRound() {
 	// 8
 }
 
+func ExampleSincos() {
+	x := decimal128.Pi().Quo(decimal128.FromInt64(6))
+	sin, cos := decimal128.Sincos(x)
+	fmt.Println(sin)
+	fmt.Println(cos)
+	// Output:
+	// 0.5
+	// 0.8660254037844386467637231707529362
+}
+
+func ExampleSinh() {
+	fmt.Println(decimal128.Sinh(decimal128.New(1, -10)))
+	fmt.Println(decimal128.Tanh(decimal128.New(5, -1)))
+	// Output:
+	// 1.0000000000000000000016666666666667e-10
+	// 0.4621171572600097585023184836436725
+}
+
+func ExampleTotalOrder() {
+	s := []decimal128.Decimal{
+		decimal128.NaN(),
+		decimal128.New(1, 0),
+		decimal128.New(0, 0),
+		decimal128.New(10, -1),
+		decimal128.New(0, 0).Neg(),
+		decimal128.Inf(-1),
+	}
+
+	slices.SortFunc(s, decimal128.TotalOrder)
+	fmt.Println(s)
+
+	for _, d := range s[3:5] {
+		fmt.Println(d.Coefficient(nil), d.Exponent())
+	}
+	// Output:
+	// [-Inf -0 0 1 1 NaN]
+	// 10 -1
+	// 1 0
+}
+
 func ExampleTrunc() {
 	x := decimal128.New(-123, -2)
 	y := decimal128.New(789, -2)
@@ -179,6 +322,14 @@ func ExampleDecimal_Ceil() {
 	// -2 places: 200
 }
 
+func ExampleDecimal_EuclidMod() {
+	x := decimal128.New(-75, -1)
+	y := decimal128.New(2, 0)
+	fmt.Printf("%g mod %g = %g\n", x, y, x.EuclidMod(y))
+	// Output:
+	// -7.5 mod 2 = 0.5
+}
+
 func ExampleDecimal_Floor() {
 	x := decimal128.New(123456, -3)
 	fmt.Println("unrounded:", x)
@@ -192,6 +343,22 @@ func ExampleDecimal_Floor() {
 	// -2 places: 100
 }
 
+func ExampleDecimal_FloorQuo() {
+	x := decimal128.New(-75, -1)
+	y := decimal128.New(2, 0)
+	fmt.Printf("floor(%g / %g) = %g\n", x, y, x.FloorQuo(y))
+	// Output:
+	// floor(-7.5 / 2) = -4
+}
+
+func ExampleDecimal_Mod() {
+	x := decimal128.New(-75, -1)
+	y := decimal128.New(2, 0)
+	fmt.Printf("%g mod %g = %g\n", x, y, x.Mod(y))
+	// Output:
+	// -7.5 mod 2 = -1.5
+}
+
 func ExampleDecimal_Mul() {
 	x := decimal128.New(3, 0)
 	y := decimal128.New(2, -1)
@@ -208,6 +375,15 @@ func ExampleDecimal_Payload() {
 	// NaN generated by Quo(Zero, Zero)
 }
 
+func ExampleDecimal_Precision() {
+	x := decimal128.New(12345, -2)
+	fmt.Println(x.Coefficient(nil), x.Exponent())
+	fmt.Println(x.Precision(), x.Scale())
+	// Output:
+	// 12345 -2
+	// 5 2
+}
+
 func ExampleDecimal_Quo() {
 	x := decimal128.New(152, -1)
 	y := decimal128.New(5, 0)
@@ -225,6 +401,35 @@ func ExampleDecimal_QuoRem() {
 	// 15.2 / 5 = (3, 0.2)
 }
 
+func ExampleDecimal_Quantize() {
+	x := decimal128.New(123456, -3)
+	q := decimal128.New(1, -2)
+	fmt.Println(x.Quantize(q, decimal128.ToNearestEven))
+	fmt.Println(x.Quantize(decimal128.New(1, 2), decimal128.ToZero))
+	// Output:
+	// 123.46
+	// 100
+}
+
+func ExampleDecimal_Remainder() {
+	x := decimal128.New(75, -1)
+	y := decimal128.New(2, 0)
+	fmt.Printf("remainder(%g, %g) = %g\n", x, y, x.Remainder(y))
+	// Output:
+	// remainder(7.5, 2) = -0.5
+}
+
+func ExampleDecimal_Rescale() {
+	x := decimal128.New(5, 0)
+	r := x.Rescale(2, decimal128.ToNearestEven)
+	_, _, _, exp := r.Decompose(nil)
+	fmt.Println("value:", r)
+	fmt.Println("exponent:", exp)
+	// Output:
+	// value: 5
+	// exponent: -2
+}
+
 func ExampleDecimal_Round() {
 	x := decimal128.New(123456, -3)
 	fmt.Println("unrounded:", x)
@@ -238,6 +443,17 @@ func ExampleDecimal_Round() {
 	// -2 places: 100
 }
 
+func ExampleDecimal_RoundToIncrement() {
+	inc := decimal128.MustParse("0.05")
+	fmt.Println(decimal128.MustParse("1.02").RoundToIncrement(inc, decimal128.ToNearestEven))
+	fmt.Println(decimal128.MustParse("1.03").RoundToIncrement(inc, decimal128.ToNearestEven))
+	fmt.Println(decimal128.MustParse("1.075").RoundToIncrement(inc, decimal128.ToNearestEven))
+	// Output:
+	// 1
+	// 1.05
+	// 1.1
+}
+
 func ExampleDecimal_Sign() {
 	x := decimal128.FromInt64(-5)
 	fmt.Printf("% g.Sign() = %d\n", x, x.Sign())
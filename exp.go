@@ -2,9 +2,21 @@ package decimal128
 
 import "math/bits"
 
-// Cbrt returns the cube root of d.
+// Cbrt returns the cube root of d, rounded using the [DefaultRoundingMode].
 func Cbrt(d Decimal) Decimal {
+	return CbrtWithMode(d, DefaultRoundingMode)
+}
+
+// CbrtWithMode returns the cube root of d, rounded using the provided rounding
+// mode.
+//
+// If the cube root can be represented exactly it is returned exactly.
+func CbrtWithMode(d Decimal, mode RoundingMode) Decimal {
 	if d.isSpecial() || d.IsZero() {
+		if d.IsNaN() {
+			return d.quiet()
+		}
+
 		return d
 	}
 
@@ -46,8 +58,14 @@ func Cbrt(d Decimal) Decimal {
 		res, trunc = res.mul(frc, trunc)
 	}
 
+	if trunc != 0 {
+		if est, ok := res.exactPow(d192, 1, 3, false); ok {
+			res, trunc = est, 0
+		}
+	}
+
 	neg := d.Signbit()
-	sig, exp := DefaultRoundingMode.reduce192(neg, res.sig, res.exp+exponentBias, trunc)
+	sig, exp := mode.reduce192(neg, res.sig, res.exp+exponentBias, trunc)
 
 	if exp > maxBiasedExponent {
 		return inf(neg)
@@ -56,11 +74,21 @@ func Cbrt(d Decimal) Decimal {
 	return compose(neg, sig, exp)
 }
 
-// Exp returns e**d, the base-e exponential of d.
+// Exp returns e**d, the base-e exponential of d, rounded using the
+// [DefaultRoundingMode].
 func Exp(d Decimal) Decimal {
+	return ExpWithMode(d, DefaultRoundingMode)
+}
+
+// ExpWithMode returns e**d, the base-e exponential of d, rounded using the
+// provided rounding mode.
+//
+// Results too large to represent are +Inf, and results smaller than a tenth of
+// the smallest subnormal value are +0, whatever the rounding mode.
+func ExpWithMode(d Decimal, mode RoundingMode) Decimal {
 	if d.isSpecial() {
 		if d.IsNaN() {
-			return d
+			return d.quiet()
 		}
 
 		if d.Signbit() {
@@ -86,6 +114,18 @@ func Exp(d Decimal) Decimal {
 		return inf(false)
 	}
 
+	if int(dExp)+l10 < -40 {
+		// e**d = 1 + d + ... is within a tiny distance of 1, so the result
+		// is either 1 or one of its neighbours.
+		trunc := int8(1)
+		if d.Signbit() {
+			trunc = -1
+		}
+
+		sig, exp := mode.reduce128(false, uint128{1, 0}, exponentBias, trunc)
+		return compose(false, sig, exp)
+	}
+
 	res, trunc := decomposed192{
 		sig: uint192{dSig[0], dSig[1], 0},
 		exp: dExp,
@@ -103,7 +143,7 @@ func Exp(d Decimal) Decimal {
 		res, trunc = res.rcp(trunc)
 	}
 
-	sig, exp := DefaultRoundingMode.reduce192(false, res.sig, res.exp+exponentBias, trunc)
+	sig, exp := mode.reduce192(false, res.sig, res.exp+exponentBias, trunc)
 
 	if exp > maxBiasedExponent {
 		if d.Signbit() {
@@ -116,11 +156,22 @@ func Exp(d Decimal) Decimal {
 	return compose(false, sig, exp)
 }
 
-// Exp10 returns 10**d, the base-10 exponential of d.
+// Exp10 returns 10**d, the base-10 exponential of d, rounded using the
+// [DefaultRoundingMode].
 func Exp10(d Decimal) Decimal {
+	return Exp10WithMode(d, DefaultRoundingMode)
+}
+
+// Exp10WithMode returns 10**d, the base-10 exponential of d, rounded using the
+// provided rounding mode.
+//
+// If d is an integer the result is exact whenever it can be represented.
+// Results too large to represent are +Inf, and results smaller than a tenth of
+// the smallest subnormal value are +0, whatever the rounding mode.
+func Exp10WithMode(d Decimal, mode RoundingMode) Decimal {
 	if d.isSpecial() {
 		if d.IsNaN() {
-			return d
+			return d.quiet()
 		}
 
 		if d.Signbit() {
@@ -146,6 +197,18 @@ func Exp10(d Decimal) Decimal {
 		return inf(false)
 	}
 
+	if int(dExp)+l10 < -40 {
+		// 10**d = 1 + d*log(10) + ... is within a tiny distance of 1, so the result
+		// is either 1 or one of its neighbours.
+		trunc := int8(1)
+		if d.Signbit() {
+			trunc = -1
+		}
+
+		sig, exp := mode.reduce128(false, uint128{1, 0}, exponentBias, trunc)
+		return compose(false, sig, exp)
+	}
+
 	var dSigInt uint
 	if l10+int(dExp) >= 0 {
 		sig := dSig
@@ -168,7 +231,9 @@ func Exp10(d Decimal) Decimal {
 			exp--
 		}
 
-		if dSigInt > maxUnbiasedExponent+58 {
+		// 10**6146 is larger than the largest Decimal value, and 10**-6178 is
+		// smaller than a tenth of the smallest subnormal value.
+		if !d.Signbit() && dSigInt >= 6146 || dSigInt >= 6178 {
 			if d.Signbit() {
 				return zero(false)
 			}
@@ -193,19 +258,7 @@ func Exp10(d Decimal) Decimal {
 	var res decomposed192
 	var trunc int8
 
-	var sigInt uint128
-	var expInt int16
-
-	if dSigInt != 0 {
-		sigInt = uint128{1, 0}
-
-		for dSigInt > maxUnbiasedExponent {
-			sigInt = sigInt.mul64(10)
-			dSigInt--
-		}
-
-		expInt = int16(dSigInt)
-	}
+	expInt := int16(dSigInt)
 
 	if dSig[0]|dSig[1] != 0 {
 		res, trunc = decomposed192{
@@ -214,18 +267,7 @@ func Exp10(d Decimal) Decimal {
 		}.mul(ln10, int8(0))
 
 		res, trunc = res.epow(int16(res.sig.log10()), trunc)
-
-		if res.exp > maxUnbiasedExponent+58 {
-			if d.Signbit() {
-				return zero(false)
-			}
-
-			return inf(false)
-		}
-
-		if expInt != 0 {
-			res.exp += expInt
-		}
+		res.exp += expInt
 	} else {
 		res = decomposed192{
 			sig: uint192{1, 0, 0},
@@ -233,19 +275,11 @@ func Exp10(d Decimal) Decimal {
 		}
 	}
 
-	if res.exp > maxUnbiasedExponent+58 {
-		if d.Signbit() {
-			return zero(false)
-		}
-
-		return inf(false)
-	}
-
 	if d.Signbit() {
 		res, trunc = res.rcp(trunc)
 	}
 
-	sig, exp := DefaultRoundingMode.reduce192(false, res.sig, res.exp+exponentBias, trunc)
+	sig, exp := mode.reduce192(false, res.sig, res.exp+exponentBias, trunc)
 
 	if exp > maxBiasedExponent {
 		if d.Signbit() {
@@ -258,11 +292,22 @@ func Exp10(d Decimal) Decimal {
 	return compose(false, sig, exp)
 }
 
-// Exp2 returns 2**d, the base-2 exponential of d.
+// Exp2 returns 2**d, the base-2 exponential of d, rounded using the
+// [DefaultRoundingMode].
 func Exp2(d Decimal) Decimal {
+	return Exp2WithMode(d, DefaultRoundingMode)
+}
+
+// Exp2WithMode returns 2**d, the base-2 exponential of d, rounded using the
+// provided rounding mode.
+//
+// If d is an integer the result is exact whenever it can be represented.
+// Results too large to represent are +Inf, and results smaller than a tenth of
+// the smallest subnormal value are +0, whatever the rounding mode.
+func Exp2WithMode(d Decimal, mode RoundingMode) Decimal {
 	if d.isSpecial() {
 		if d.IsNaN() {
-			return d
+			return d.quiet()
 		}
 
 		if d.Signbit() {
@@ -288,6 +333,18 @@ func Exp2(d Decimal) Decimal {
 		return inf(false)
 	}
 
+	if int(dExp)+l10 < -40 {
+		// 2**d = 1 + d*log(2) + ... is within a tiny distance of 1, so the result
+		// is either 1 or one of its neighbours.
+		trunc := int8(1)
+		if d.Signbit() {
+			trunc = -1
+		}
+
+		sig, exp := mode.reduce128(false, uint128{1, 0}, exponentBias, trunc)
+		return compose(false, sig, exp)
+	}
+
 	var dSigInt uint
 	if l10+int(dExp) >= 0 {
 		sig := dSig
@@ -331,7 +388,9 @@ func Exp2(d Decimal) Decimal {
 	var expInt int16
 
 	if dSigInt != 0 {
-		if dSigInt > exponentBias+maxDigits {
+		// 2**20414 is larger than the largest Decimal value, and 2**-20520 is
+		// smaller than a tenth of the smallest subnormal value.
+		if !d.Signbit() && dSigInt >= 20414 || dSigInt >= 20520 {
 			if d.Signbit() {
 				return zero(false)
 			}
@@ -375,10 +434,20 @@ func Exp2(d Decimal) Decimal {
 				}
 			}
 
+			for sigInt256[3] >= 0x0000_0000_0fff_ffff {
+				var rem uint64
+				sigInt256, rem = sigInt256.div1e8()
+				expInt += 8
+
+				if rem != 0 {
+					trunc = 1
+				}
+			}
+
 			for sigInt256[3] > 0 {
 				var rem uint64
-				sigInt256, rem = sigInt256.div1e19()
-				expInt += 19
+				sigInt256, rem = sigInt256.div10()
+				expInt++
 
 				if rem != 0 {
 					trunc = 1
@@ -430,7 +499,7 @@ func Exp2(d Decimal) Decimal {
 		res, trunc = res.rcp(trunc)
 	}
 
-	sig, exp := DefaultRoundingMode.reduce192(false, res.sig, res.exp+exponentBias, trunc)
+	sig, exp := mode.reduce192(false, res.sig, res.exp+exponentBias, trunc)
 
 	if exp > maxBiasedExponent {
 		if d.Signbit() {
@@ -443,12 +512,19 @@ func Exp2(d Decimal) Decimal {
 	return compose(false, sig, exp)
 }
 
-// Expm1 returns e**d - 1, the base-e exponential of d minus 1. It is more
-// accurate than Exp(d) - 1 when d is near zero.
+// Expm1 returns e**d - 1, the base-e exponential of d minus 1, rounded using
+// the [DefaultRoundingMode]. It is more accurate than Exp(d) - 1 when d is near
+// zero.
 func Expm1(d Decimal) Decimal {
+	return Expm1WithMode(d, DefaultRoundingMode)
+}
+
+// Expm1WithMode returns e**d - 1, the base-e exponential of d minus 1, rounded
+// using the provided rounding mode.
+func Expm1WithMode(d Decimal, mode RoundingMode) Decimal {
 	if d.isSpecial() {
 		if d.IsNaN() {
-			return d
+			return d.quiet()
 		}
 
 		if d.Signbit() {
@@ -466,12 +542,27 @@ func Expm1(d Decimal) Decimal {
 	dExp -= exponentBias
 	l10 := dSig.log10()
 
+	if d.Signbit() && int(dExp)+l10 >= 3 {
+		// e**d is far smaller than the spacing between values near 1, so
+		// the result is a tiny distance above -1.
+		sig, exp := mode.reduce128(true, uint128{1, 0}, exponentBias, -1)
+		return compose(true, sig, exp)
+	}
+
 	if int(dExp) > 5-l10 {
+		return inf(false)
+	}
+
+	if int(dExp)+l10 < -40 {
+		// e**d - 1 = d + d**2/2 + ..., so the result is a tiny distance above
+		// d, and the second term is too small to reach the next value.
+		trunc := int8(1)
 		if d.Signbit() {
-			return one(true)
+			trunc = -1
 		}
 
-		return inf(false)
+		sig, exp := mode.reduce128(d.Signbit(), dSig, dExp+exponentBias, trunc)
+		return compose(d.Signbit(), sig, exp)
 	}
 
 	neg, res, trunc := decomposed192{
@@ -487,7 +578,7 @@ func Expm1(d Decimal) Decimal {
 		return inf(false)
 	}
 
-	sig, exp := DefaultRoundingMode.reduce192(neg, res.sig, res.exp+exponentBias, trunc)
+	sig, exp := mode.reduce192(neg, res.sig, res.exp+exponentBias, trunc)
 
 	if exp > maxBiasedExponent {
 		if d.Signbit() {
@@ -500,11 +591,18 @@ func Expm1(d Decimal) Decimal {
 	return compose(neg, sig, exp)
 }
 
-// Log returns the natural logarithm of d.
+// Log returns the natural logarithm of d, rounded using the
+// [DefaultRoundingMode].
 func Log(d Decimal) Decimal {
+	return LogWithMode(d, DefaultRoundingMode)
+}
+
+// LogWithMode returns the natural logarithm of d, rounded using the provided
+// rounding mode.
+func LogWithMode(d Decimal, mode RoundingMode) Decimal {
 	if d.isSpecial() {
 		if d.IsNaN() {
-			return d
+			return d.quiet()
 		}
 
 		if d.Signbit() {
@@ -529,7 +627,7 @@ func Log(d Decimal) Decimal {
 		exp: dExp - exponentBias,
 	}.log()
 
-	sig, exp := DefaultRoundingMode.reduce192(neg, res.sig, res.exp+exponentBias, trunc)
+	sig, exp := mode.reduce192(neg, res.sig, res.exp+exponentBias, trunc)
 
 	if exp > maxBiasedExponent {
 		return inf(neg)
@@ -538,11 +636,20 @@ func Log(d Decimal) Decimal {
 	return compose(neg, sig, exp)
 }
 
-// Log10 returns the decimal logarithm of d.
+// Log10 returns the decimal logarithm of d, rounded using the
+// [DefaultRoundingMode].
 func Log10(d Decimal) Decimal {
+	return Log10WithMode(d, DefaultRoundingMode)
+}
+
+// Log10WithMode returns the decimal logarithm of d, rounded using the provided
+// rounding mode.
+//
+// The logarithm of an exact power of ten is returned exactly.
+func Log10WithMode(d Decimal, mode RoundingMode) Decimal {
 	if d.isSpecial() {
 		if d.IsNaN() {
-			return d
+			return d.quiet()
 		}
 
 		if d.Signbit() {
@@ -562,6 +669,27 @@ func Log10(d Decimal) Decimal {
 
 	dSig, dExp := d.decompose()
 
+	intSig, intExp := dSig, dExp
+	for {
+		sig, rem := intSig.div10()
+		if rem != 0 {
+			break
+		}
+
+		intSig = sig
+		intExp++
+	}
+
+	if intSig == (uint128{1, 0}) {
+		// The decimal logarithm of a power of ten is an integer.
+		exp := int64(intExp) - exponentBias
+		if exp < 0 {
+			return compose(true, uint128{uint64(-exp), 0}, exponentBias)
+		}
+
+		return compose(false, uint128{uint64(exp), 0}, exponentBias)
+	}
+
 	neg, res, trunc := decomposed192{
 		sig: uint192{dSig[0], dSig[1], 0},
 		exp: dExp - exponentBias,
@@ -569,7 +697,7 @@ func Log10(d Decimal) Decimal {
 
 	res, trunc = res.mul(invLn10, trunc)
 
-	sig, exp := DefaultRoundingMode.reduce192(neg, res.sig, res.exp+exponentBias, trunc)
+	sig, exp := mode.reduce192(neg, res.sig, res.exp+exponentBias, trunc)
 
 	if exp > maxBiasedExponent {
 		return inf(neg)
@@ -578,12 +706,19 @@ func Log10(d Decimal) Decimal {
 	return compose(neg, sig, exp)
 }
 
-// Log1p returns the natural logarithm 1 plus d. It is more accurate than
-// Log(1 + d) when d is near zero.
+// Log1p returns the natural logarithm 1 plus d, rounded using the
+// [DefaultRoundingMode]. It is more accurate than Log(1 + d) when d is near
+// zero.
 func Log1p(d Decimal) Decimal {
+	return Log1pWithMode(d, DefaultRoundingMode)
+}
+
+// Log1pWithMode returns the natural logarithm 1 plus d, rounded using the
+// provided rounding mode.
+func Log1pWithMode(d Decimal, mode RoundingMode) Decimal {
 	if d.isSpecial() {
 		if d.IsNaN() {
-			return d
+			return d.quiet()
 		}
 
 		if d.Signbit() {
@@ -631,7 +766,7 @@ func Log1p(d Decimal) Decimal {
 
 		neg, res, trunc := res.log()
 
-		sig, exp := DefaultRoundingMode.reduce192(neg, res.sig, res.exp+exponentBias, trunc)
+		sig, exp := mode.reduce192(neg, res.sig, res.exp+exponentBias, trunc)
 
 		if exp > maxBiasedExponent {
 			return inf(neg)
@@ -645,7 +780,7 @@ func Log1p(d Decimal) Decimal {
 		exp: dExp,
 	}.log1p(d.Signbit())
 
-	sig, exp := DefaultRoundingMode.reduce192(neg, res.sig, res.exp+exponentBias, trunc)
+	sig, exp := mode.reduce192(neg, res.sig, res.exp+exponentBias, trunc)
 
 	if exp > maxBiasedExponent {
 		return inf(neg)
@@ -654,11 +789,20 @@ func Log1p(d Decimal) Decimal {
 	return compose(neg, sig, exp)
 }
 
-// Log2 returns the binary logarithm of d.
+// Log2 returns the binary logarithm of d, rounded using the
+// [DefaultRoundingMode].
 func Log2(d Decimal) Decimal {
+	return Log2WithMode(d, DefaultRoundingMode)
+}
+
+// Log2WithMode returns the binary logarithm of d, rounded using the provided
+// rounding mode.
+//
+// The logarithm of an exact power of two is returned exactly.
+func Log2WithMode(d Decimal, mode RoundingMode) Decimal {
 	if d.isSpecial() {
 		if d.IsNaN() {
-			return d
+			return d.quiet()
 		}
 
 		if d.Signbit() {
@@ -678,6 +822,32 @@ func Log2(d Decimal) Decimal {
 
 	dSig, dExp := d.decompose()
 
+	intSig, intExp := dSig, dExp
+	for {
+		sig, rem := intSig.div10()
+		if rem != 0 {
+			break
+		}
+
+		intSig = sig
+		intExp++
+	}
+
+	// The binary logarithm of a power of two is an integer. Positive powers
+	// of two are integers with no trailing zeros, while 2**-n = 5**n / 10**n.
+	if intExp == exponentBias && bits.OnesCount64(intSig[0])+bits.OnesCount64(intSig[1]) == 1 {
+		exp := uint64(bits.TrailingZeros64(intSig[0]))
+		if intSig[0] == 0 {
+			exp = 64 + uint64(bits.TrailingZeros64(intSig[1]))
+		}
+
+		return compose(false, uint128{exp, 0}, exponentBias)
+	}
+
+	if n := int(exponentBias - intExp); n > 0 && n < len(uint128PowersOf10) && uint128PowersOf10[n].rsh(uint(n)) == intSig {
+		return compose(true, uint128{uint64(n), 0}, exponentBias)
+	}
+
 	neg, res, trunc := decomposed192{
 		sig: uint192{dSig[0], dSig[1], 0},
 		exp: dExp - exponentBias,
@@ -685,7 +855,211 @@ func Log2(d Decimal) Decimal {
 
 	res, trunc = res.mul(invLn2, trunc)
 
-	sig, exp := DefaultRoundingMode.reduce192(neg, res.sig, res.exp+exponentBias, trunc)
+	sig, exp := mode.reduce192(neg, res.sig, res.exp+exponentBias, trunc)
+
+	if exp > maxBiasedExponent {
+		return inf(neg)
+	}
+
+	return compose(neg, sig, exp)
+}
+
+// PowInt raises d to the power of n, rounding using the [DefaultRoundingMode],
+// and returns the result.
+func PowInt(d Decimal, n int64) Decimal {
+	return PowIntWithMode(d, n, DefaultRoundingMode)
+}
+
+// PowIntWithMode raises d to the power of n, rounding using the provided
+// rounding mode, and returns the result.
+//
+// The power is calculated by repeated squaring rather than through logarithms,
+// and the result is only rounded once, at the end. If the result can be
+// represented exactly it is returned exactly, otherwise its relative error
+// before rounding is below |n| parts in 10**57 rather than the bound described
+// on [RoundingMode].
+func PowIntWithMode(d Decimal, n int64, mode RoundingMode) Decimal {
+	if d.IsSignalingNaN() {
+		return d.quiet()
+	}
+
+	if n == 0 {
+		return one(false)
+	}
+
+	if d.IsNaN() {
+		return d.quiet()
+	}
+
+	if n == 1 {
+		return d
+	}
+
+	if n == -1 {
+		return one(false).QuoWithMode(d, mode)
+	}
+
+	neg := d.Signbit() && n&1 != 0
+
+	if d.isInf() {
+		if n < 0 {
+			return zero(neg)
+		}
+
+		return inf(neg)
+	}
+
+	if d.IsZero() {
+		if n < 0 {
+			return inf(neg)
+		}
+
+		return zero(neg)
+	}
+
+	dSig, dExp := d.decompose()
+
+	for {
+		sig, rem := dSig.div10()
+		if rem != 0 {
+			break
+		}
+
+		dSig = sig
+		dExp++
+	}
+
+	val := decomposed192{
+		sig: uint192{dSig[0], dSig[1], 0},
+		exp: dExp - exponentBias,
+	}
+
+	var trunc int8
+	k := uint64(n)
+
+	if n < 0 {
+		// Take the reciprocal first, so that every step of the calculation
+		// truncates in the same direction.
+		val, trunc = val.rcp(0)
+		k = -k
+	}
+
+	res, trunc := val.powInt(k, trunc)
+
+	sig, exp := mode.reduce192(neg, res.sig, res.exp+exponentBias, trunc)
+
+	if exp > maxBiasedExponent {
+		return inf(neg)
+	}
+
+	return compose(neg, sig, exp)
+}
+
+// Root returns the nth root of d, rounded using the [DefaultRoundingMode].
+func Root(d Decimal, n int) Decimal {
+	return RootWithMode(d, n, DefaultRoundingMode)
+}
+
+// RootWithMode returns the nth root of d, rounded using the provided rounding
+// mode. A negative n returns the reciprocal of the |n|th root.
+//
+// If the root can be represented exactly it is returned exactly.
+//
+// Special cases are:
+//
+//	Root(x, 0) = NaN
+//	Root(±0, n) = ±0 for odd n > 0
+//	Root(±0, n) = +0 for even n > 0
+//	Root(±0, n) = ±Inf for odd n < 0
+//	Root(±0, n) = +Inf for even n < 0
+//	Root(+Inf, n) = +Inf for n > 0
+//	Root(+Inf, n) = +0 for n < 0
+//	Root(-Inf, n) = -Inf for odd n > 0
+//	Root(-Inf, n) = -0 for odd n < 0
+//	Root(x, n) = NaN for x < 0 and even n
+//	Root(NaN, n) = NaN
+func RootWithMode(d Decimal, n int, mode RoundingMode) Decimal {
+	if d.IsNaN() {
+		return d.quiet()
+	}
+
+	nVal := payloadValPosFinite
+	if n < 0 {
+		nVal = payloadValNegFinite
+	}
+
+	if n == 0 {
+		return nan(payloadOpRoot, payloadVal(d), payloadValPosZero)
+	}
+
+	neg := d.Signbit()
+
+	if neg && n&1 == 0 && !d.IsZero() {
+		return nan(payloadOpRoot, payloadVal(d), nVal)
+	}
+
+	if d.isSpecial() {
+		if n < 0 {
+			return zero(neg)
+		}
+
+		return d
+	}
+
+	if d.IsZero() {
+		neg = neg && n&1 != 0
+
+		if n < 0 {
+			return inf(neg)
+		}
+
+		return zero(neg)
+	}
+
+	switch n {
+	case 1:
+		return d
+	case -1:
+		return one(false).QuoWithMode(d, mode)
+	}
+
+	k := uint64(n)
+	if n < 0 {
+		k = -k
+	}
+
+	dSig, dExp := d.decompose()
+
+	val := decomposed192{
+		sig: uint192{dSig[0], dSig[1], 0},
+		exp: dExp - exponentBias,
+	}
+
+	inv, res, trunc := val.log()
+
+	if res.sig[0]|res.sig[1]|res.sig[2] == 0 {
+		return one(neg)
+	}
+
+	res, trunc = res.quo(decomposed192{
+		sig: uint192{k, 0, 0},
+		exp: 0,
+	}, trunc)
+
+	res, trunc = res.epow(int16(res.sig.log10()), trunc)
+
+	if inv != (n < 0) {
+		res, trunc = res.rcp(trunc)
+		trunc *= -1
+	}
+
+	if trunc != 0 {
+		if est, ok := res.exactPow(val, 1, k, n < 0); ok {
+			res, trunc = est, 0
+		}
+	}
+
+	sig, exp := mode.reduce192(neg, res.sig, res.exp+exponentBias, trunc)
 
 	if exp > maxBiasedExponent {
 		return inf(neg)
@@ -694,11 +1068,19 @@ func Log2(d Decimal) Decimal {
 	return compose(neg, sig, exp)
 }
 
-// Sqrt returns the square root of d.
+// Sqrt returns the square root of d, rounded using the [DefaultRoundingMode].
 func Sqrt(d Decimal) Decimal {
+	return SqrtWithMode(d, DefaultRoundingMode)
+}
+
+// SqrtWithMode returns the square root of d, rounded using the provided
+// rounding mode.
+//
+// If the square root can be represented exactly it is returned exactly.
+func SqrtWithMode(d Decimal, mode RoundingMode) Decimal {
 	if d.isSpecial() {
 		if d.IsNaN() {
-			return d
+			return d.quiet()
 		}
 
 		if d.Signbit() {
@@ -718,6 +1100,12 @@ func Sqrt(d Decimal) Decimal {
 
 	dSig, dExp := d.decompose()
 	l10 := int16(dSig.log10())
+
+	val := decomposed192{
+		sig: uint192{dSig[0], dSig[1], 0},
+		exp: dExp - exponentBias,
+	}
+
 	dExp = (dExp - exponentBias) + l10
 
 	var add decomposed192
@@ -773,7 +1161,14 @@ func Sqrt(d Decimal) Decimal {
 	}
 
 	res.exp += dExp / 2
-	sig, exp := DefaultRoundingMode.reduce192(false, res.sig, res.exp+exponentBias, trunc)
+
+	if trunc != 0 {
+		if est, ok := res.exactPow(val, 1, 2, false); ok {
+			res, trunc = est, 0
+		}
+	}
+
+	sig, exp := mode.reduce192(false, res.sig, res.exp+exponentBias, trunc)
 
 	if exp > maxBiasedExponent {
 		return inf(false)
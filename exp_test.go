@@ -2,6 +2,7 @@ package decimal128
 
 import (
 	"math"
+	"math/rand/v2"
 	"testing"
 )
 
@@ -20,6 +21,13 @@ func TestCbrt(t *testing.T) {
 		if !resultEqual(root, res) {
 			t.Errorf("Cbrt(%v) = %v, want %v", val, root, res)
 		}
+
+		lo := CbrtWithMode(val, ToNegativeInf)
+		hi := CbrtWithMode(val, ToPositiveInf)
+
+		if !res.IsNaN() && (lo.Cmp(res).Greater() || hi.Cmp(res).Less()) {
+			t.Errorf("CbrtWithMode(%v, ToNegativeInf/ToPositiveInf) = [%v, %v], want interval containing %v", val, lo, hi, res)
+		}
 	}
 }
 
@@ -38,6 +46,13 @@ func TestExp(t *testing.T) {
 		if !resultEqual(exp, res) {
 			t.Errorf("Exp(%v) = %v, want %v", val, exp, res)
 		}
+
+		lo := ExpWithMode(val, ToNegativeInf)
+		hi := ExpWithMode(val, ToPositiveInf)
+
+		if !res.IsNaN() && (lo.Cmp(res).Greater() || hi.Cmp(res).Less()) {
+			t.Errorf("ExpWithMode(%v, ToNegativeInf/ToPositiveInf) = [%v, %v], want interval containing %v", val, lo, hi, res)
+		}
 	}
 }
 
@@ -56,6 +71,13 @@ func TestExp10(t *testing.T) {
 		if !resultEqual(exp, res) {
 			t.Errorf("Exp10(%v) = %v, want %v", val, exp, res)
 		}
+
+		lo := Exp10WithMode(val, ToNegativeInf)
+		hi := Exp10WithMode(val, ToPositiveInf)
+
+		if !res.IsNaN() && (lo.Cmp(res).Greater() || hi.Cmp(res).Less()) {
+			t.Errorf("Exp10WithMode(%v, ToNegativeInf/ToPositiveInf) = [%v, %v], want interval containing %v", val, lo, hi, res)
+		}
 	}
 }
 
@@ -74,6 +96,13 @@ func TestExp2(t *testing.T) {
 		if !resultEqual(exp, res) {
 			t.Errorf("Exp2(%v) = %v, want %v", val, exp, res)
 		}
+
+		lo := Exp2WithMode(val, ToNegativeInf)
+		hi := Exp2WithMode(val, ToPositiveInf)
+
+		if !res.IsNaN() && (lo.Cmp(res).Greater() || hi.Cmp(res).Less()) {
+			t.Errorf("Exp2WithMode(%v, ToNegativeInf/ToPositiveInf) = [%v, %v], want interval containing %v", val, lo, hi, res)
+		}
 	}
 }
 
@@ -92,6 +121,42 @@ func TestExpm1(t *testing.T) {
 		if !resultEqual(exp, res) {
 			t.Errorf("Expm1(%v) = %v, want %v", val, exp, res)
 		}
+
+		lo := Expm1WithMode(val, ToNegativeInf)
+		hi := Expm1WithMode(val, ToPositiveInf)
+
+		if !res.IsNaN() && (lo.Cmp(res).Greater() || hi.Cmp(res).Less()) {
+			t.Errorf("Expm1WithMode(%v, ToNegativeInf/ToPositiveInf) = [%v, %v], want interval containing %v", val, lo, hi, res)
+		}
+	}
+}
+
+func TestExpm1Bounds(t *testing.T) {
+	t.Parallel()
+
+	rnd := rand.New(rand.NewPCG(1, 2))
+
+	for range 1000 {
+		// Values with a magnitude in [1e-40, 1e-20), where d**2/2 is far
+		// below the last digit of d.
+		sig := int64(rnd.Uint64N(9e18) + 1e18)
+		if rnd.IntN(2) == 0 {
+			sig = -sig
+		}
+
+		d := New(sig, -58+rnd.IntN(20))
+
+		lo := Expm1WithMode(d, ToNegativeInf)
+		hi := Expm1WithMode(d, ToPositiveInf)
+		val := oracleExpm1(d).approx(oracleMinPrec)
+
+		if oracleFloat(lo, oracleMinPrec).Cmp(val) > 0 || oracleFloat(hi, oracleMinPrec).Cmp(val) < 0 {
+			t.Errorf("Expm1WithMode(%v, ToNegativeInf/ToPositiveInf) = [%v, %v], want interval containing %v", d, lo, hi, val.Text('e', 40))
+		}
+
+		if !NextUp(lo).Equal(hi) {
+			t.Errorf("Expm1WithMode(%v, ToNegativeInf/ToPositiveInf) = [%v, %v], want neighbouring values", d, lo, hi)
+		}
 	}
 }
 
@@ -110,6 +175,13 @@ func TestLog(t *testing.T) {
 		if !resultEqual(log, res) {
 			t.Errorf("Log(%v) = %v, want %v", val, log, res)
 		}
+
+		lo := LogWithMode(val, ToNegativeInf)
+		hi := LogWithMode(val, ToPositiveInf)
+
+		if !res.IsNaN() && (lo.Cmp(res).Greater() || hi.Cmp(res).Less()) {
+			t.Errorf("LogWithMode(%v, ToNegativeInf/ToPositiveInf) = [%v, %v], want interval containing %v", val, lo, hi, res)
+		}
 	}
 }
 
@@ -128,6 +200,13 @@ func TestLog10(t *testing.T) {
 		if !resultEqual(log, res) {
 			t.Errorf("Log10(%v) = %v, want %v", val, log, res)
 		}
+
+		lo := Log10WithMode(val, ToNegativeInf)
+		hi := Log10WithMode(val, ToPositiveInf)
+
+		if !res.IsNaN() && (lo.Cmp(res).Greater() || hi.Cmp(res).Less()) {
+			t.Errorf("Log10WithMode(%v, ToNegativeInf/ToPositiveInf) = [%v, %v], want interval containing %v", val, lo, hi, res)
+		}
 	}
 }
 
@@ -146,6 +225,13 @@ func TestLog1p(t *testing.T) {
 		if !resultEqual(log, res) {
 			t.Errorf("Log1p(%v) = %v, want %v", val, log, res)
 		}
+
+		lo := Log1pWithMode(val, ToNegativeInf)
+		hi := Log1pWithMode(val, ToPositiveInf)
+
+		if !res.IsNaN() && (lo.Cmp(res).Greater() || hi.Cmp(res).Less()) {
+			t.Errorf("Log1pWithMode(%v, ToNegativeInf/ToPositiveInf) = [%v, %v], want interval containing %v", val, lo, hi, res)
+		}
 	}
 }
 
@@ -164,6 +250,55 @@ func TestLog2(t *testing.T) {
 		if !resultEqual(log, res) {
 			t.Errorf("Log2(%v) = %v, want %v", val, log, res)
 		}
+
+		lo := Log2WithMode(val, ToNegativeInf)
+		hi := Log2WithMode(val, ToPositiveInf)
+
+		if !res.IsNaN() && (lo.Cmp(res).Greater() || hi.Cmp(res).Less()) {
+			t.Errorf("Log2WithMode(%v, ToNegativeInf/ToPositiveInf) = [%v, %v], want interval containing %v", val, lo, hi, res)
+		}
+	}
+}
+
+func TestPowInt(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var n int64
+	var res testDataResult
+
+	for r.scan("%v ^ %d = %v\n", &val, &n, &res) {
+		for _, mode := range roundingModes {
+			pwr := PowIntWithMode(val, n, mode)
+
+			if !res.equal(pwr, mode) {
+				t.Errorf("PowIntWithMode(%v, %d, %v) = %v, want %v", val, n, mode, pwr, res.result(mode))
+			}
+		}
+	}
+}
+
+func TestRoot(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var n int
+	var res testDataResult
+
+	for r.scan("root(%v, %d) = %v\n", &val, &n, &res) {
+		for _, mode := range roundingModes {
+			root := RootWithMode(val, n, mode)
+
+			if !res.equal(root, mode) {
+				t.Errorf("RootWithMode(%v, %d, %v) = %v, want %v", val, n, mode, root, res.result(mode))
+			}
+		}
 	}
 }
 
@@ -182,6 +317,13 @@ func TestSqrt(t *testing.T) {
 		if !resultEqual(root, res) {
 			t.Errorf("Sqrt(%v) = %v, want %v", val, root, res)
 		}
+
+		lo := SqrtWithMode(val, ToNegativeInf)
+		hi := SqrtWithMode(val, ToPositiveInf)
+
+		if !res.IsNaN() && (lo.Cmp(res).Greater() || hi.Cmp(res).Less()) {
+			t.Errorf("SqrtWithMode(%v, ToNegativeInf/ToPositiveInf) = [%v, %v], want interval containing %v", val, lo, hi, res)
+		}
 	}
 }
 
@@ -0,0 +1,20 @@
+package finance_test
+
+import (
+	"fmt"
+
+	"github.com/woodsbury/decimal128"
+	"github.com/woodsbury/decimal128/finance"
+)
+
+func ExamplePMT() {
+	// A loan of 250,000 over 30 years at 6.5% a year, repaid monthly.
+	rate := decimal128.MustParse("0.065").Quo(decimal128.New(12, 0))
+	nper := decimal128.New(360, 0)
+	pv := decimal128.New(250_000, 0)
+
+	pmt := finance.PMT(rate, nper, pv, decimal128.Decimal{}, finance.EndOfPeriod)
+	fmt.Println(pmt.Round(2, decimal128.ToNearestAway))
+	// Output:
+	// -1580.17
+}
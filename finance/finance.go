@@ -0,0 +1,382 @@
+// Package finance provides time value of money functions over Decimal values,
+// compatible with the functions of the same names found in spreadsheets.
+//
+// Rates are given per period, so an annual rate of 6% paid monthly is a rate
+// of 0.06/12. As in spreadsheets, money paid out is negative and money
+// received is positive.
+//
+// Compounding is calculated with [decimal128.Log1p] and [decimal128.Expm1],
+// which remain accurate for very small rates, and intermediate results are
+// rounded using the [decimal128.DefaultRoundingMode]. The results are not
+// correctly rounded, but are typically accurate to around 30 significant
+// digits. Rates are solved for using Newton's method, stopping once successive
+// estimates agree to within 1 part in 10**28. Invalid arguments, and rates
+// that cannot be found, give a result of NaN.
+package finance
+
+import (
+	"time"
+
+	"github.com/woodsbury/decimal128"
+)
+
+// Timing specifies whether payments are made at the start or the end of each
+// period.
+type Timing int
+
+const (
+	// EndOfPeriod means payments are made at the end of each period. It
+	// corresponds to a type argument of 0 in spreadsheets.
+	EndOfPeriod Timing = iota
+
+	// StartOfPeriod means payments are made at the start of each period. It
+	// corresponds to a type argument of 1 in spreadsheets.
+	StartOfPeriod
+)
+
+const maxIterations = 100
+
+var (
+	one       = decimal128.New(1, 0)
+	tolerance = decimal128.New(1, -28)
+)
+
+// FV returns the future value of an investment with a present value of pv,
+// after nper periods of payments of pmt, at the provided rate per period.
+//
+// Special cases are:
+//
+//	FV(rate, nper, pmt, pv, when) = NaN if any argument is NaN or infinite
+//	FV(rate, nper, pmt, pv, when) = NaN if rate <= -1
+func FV(rate, nper, pmt, pv decimal128.Decimal, when Timing) decimal128.Decimal {
+	if !finite(rate, nper, pmt, pv) || !validRate(rate) {
+		return decimal128.NaN()
+	}
+
+	if rate.IsZero() {
+		return pv.Add(pmt.Mul(nper)).Neg()
+	}
+
+	g, gm1 := growth(rate, nper)
+	return pv.Mul(g).Add(annuity(rate, gm1, pmt, when)).Neg()
+}
+
+// IRR returns the internal rate of return of a series of cash flows made at
+// regular intervals, the rate at which their net present value is zero,
+// starting the search for the rate from guess. A guess of 0.1 is suitable for
+// most cash flows.
+//
+// Special cases are:
+//
+//	IRR(values, guess) = NaN if values does not contain both positive and negative values
+//	IRR(values, guess) = NaN if any argument is NaN or infinite
+//	IRR(values, guess) = NaN if the rate does not converge
+func IRR(values []decimal128.Decimal, guess decimal128.Decimal) decimal128.Decimal {
+	if !finite(values...) || !finite(guess) || !signChange(values) {
+		return decimal128.NaN()
+	}
+
+	return solve(guess, func(rate decimal128.Decimal) (decimal128.Decimal, decimal128.Decimal) {
+		// f(r) = Σ v[i] (1 + r)**-i
+		// f'(r) = Σ -i v[i] (1 + r)**(-i - 1)
+		var f, df decimal128.Accumulator
+
+		lg := decimal128.Log1p(rate)
+		for i, v := range values {
+			if i == 0 {
+				f.Add(v)
+				continue
+			}
+
+			t := decimal128.New(int64(i), 0)
+			d := v.Mul(decimal128.Exp(lg.Mul(t).Neg()))
+
+			f.Add(d)
+			df.Add(d.Mul(t).Neg())
+		}
+
+		y, _ := f.Result()
+		dy, _ := df.Result()
+		return y, dy.Quo(one.Add(rate))
+	})
+}
+
+// NPV returns the net present value of a series of cash flows made at the end
+// of each period, discounted at the provided rate per period.
+//
+// Special cases are:
+//
+//	NPV(rate, values) = NaN if any argument is NaN or infinite
+//	NPV(rate, values) = NaN if rate <= -1
+func NPV(rate decimal128.Decimal, values []decimal128.Decimal) decimal128.Decimal {
+	if !finite(values...) || !finite(rate) || !validRate(rate) {
+		return decimal128.NaN()
+	}
+
+	var acc decimal128.Accumulator
+
+	lg := decimal128.Log1p(rate)
+	for i, v := range values {
+		t := decimal128.New(int64(i+1), 0)
+		acc.Add(v.Mul(decimal128.Exp(lg.Mul(t).Neg())))
+	}
+
+	res, _ := acc.Result()
+	return res
+}
+
+// NPer returns the number of periods needed for an investment with a present
+// value of pv to reach a future value of fv, with payments of pmt each period
+// at the provided rate per period.
+//
+// Special cases are:
+//
+//	NPer(rate, pmt, pv, fv, when) = NaN if any argument is NaN or infinite
+//	NPer(rate, pmt, pv, fv, when) = NaN if rate <= -1
+//	NPer(rate, pmt, pv, fv, when) = NaN if fv cannot be reached
+func NPer(rate, pmt, pv, fv decimal128.Decimal, when Timing) decimal128.Decimal {
+	if !finite(rate, pmt, pv, fv) || !validRate(rate) {
+		return decimal128.NaN()
+	}
+
+	if rate.IsZero() {
+		res := pv.Add(fv).Neg().Quo(pmt)
+		if !finite(res) {
+			return decimal128.NaN()
+		}
+
+		return res
+	}
+
+	// nper = log((a - fv) / (a + pv)) / log(1 + rate)
+	// where a = pmt (1 + rate × when) / rate
+	a := pmt.Quo(rate)
+	if when == StartOfPeriod {
+		a = a.Mul(one.Add(rate))
+	}
+
+	res := decimal128.Log(a.Sub(fv).Quo(a.Add(pv))).Quo(decimal128.Log1p(rate))
+	if !finite(res) {
+		return decimal128.NaN()
+	}
+
+	return res
+}
+
+// PMT returns the payment needed each period for an investment with a present
+// value of pv to reach a future value of fv after nper periods, at the
+// provided rate per period.
+//
+// Special cases are:
+//
+//	PMT(rate, nper, pv, fv, when) = NaN if any argument is NaN or infinite
+//	PMT(rate, nper, pv, fv, when) = NaN if rate <= -1
+//	PMT(rate, nper, pv, fv, when) = NaN if nper is zero
+func PMT(rate, nper, pv, fv decimal128.Decimal, when Timing) decimal128.Decimal {
+	if !finite(rate, nper, pv, fv) || !validRate(rate) || nper.IsZero() {
+		return decimal128.NaN()
+	}
+
+	if rate.IsZero() {
+		return pv.Add(fv).Neg().Quo(nper)
+	}
+
+	g, gm1 := growth(rate, nper)
+	return fv.Add(pv.Mul(g)).Neg().Quo(annuity(rate, gm1, one, when))
+}
+
+// PV returns the present value of an investment that reaches a future value of
+// fv after nper periods of payments of pmt, at the provided rate per period.
+//
+// Special cases are:
+//
+//	PV(rate, nper, pmt, fv, when) = NaN if any argument is NaN or infinite
+//	PV(rate, nper, pmt, fv, when) = NaN if rate <= -1
+func PV(rate, nper, pmt, fv decimal128.Decimal, when Timing) decimal128.Decimal {
+	if !finite(rate, nper, pmt, fv) || !validRate(rate) {
+		return decimal128.NaN()
+	}
+
+	if rate.IsZero() {
+		return fv.Add(pmt.Mul(nper)).Neg()
+	}
+
+	g, gm1 := growth(rate, nper)
+	return fv.Add(annuity(rate, gm1, pmt, when)).Neg().Quo(g)
+}
+
+// Rate returns the interest rate per period needed for an investment with a
+// present value of pv to reach a future value of fv after nper periods of
+// payments of pmt, starting the search for the rate from guess. A guess of 0.1
+// is suitable for most investments.
+//
+// Special cases are:
+//
+//	Rate(nper, pmt, pv, fv, when, guess) = NaN if any argument is NaN or infinite
+//	Rate(nper, pmt, pv, fv, when, guess) = NaN if nper is zero
+//	Rate(nper, pmt, pv, fv, when, guess) = NaN if the rate does not converge
+func Rate(nper, pmt, pv, fv decimal128.Decimal, when Timing, guess decimal128.Decimal) decimal128.Decimal {
+	if !finite(nper, pmt, pv, fv, guess) || nper.IsZero() {
+		return decimal128.NaN()
+	}
+
+	t := decimal128.New(int64(when), 0)
+
+	return solve(guess, func(rate decimal128.Decimal) (decimal128.Decimal, decimal128.Decimal) {
+		if rate.IsZero() {
+			// f(0) = pv + pmt × nper + fv
+			// f'(0) = pv × nper + pmt × (when × nper + nper (nper - 1) / 2)
+			y := pv.Add(pmt.Mul(nper)).Add(fv)
+			dy := pv.Mul(nper).Add(pmt.Mul(t.Mul(nper).Add(nper.Mul(nper.Sub(one)).Quo(decimal128.New(2, 0)))))
+			return y, dy
+		}
+
+		// f(r) = pv g + pmt (1 + r × when) (g - 1) / r + fv
+		// f'(r) = pv n g / (1 + r) + pmt when (g - 1) / r
+		//       + pmt (1 + r × when) (n g / (1 + r) / r - (g - 1) / r**2)
+		g, gm1 := growth(rate, nper)
+		ng := nper.Mul(g).Quo(one.Add(rate))
+		fac := one.Add(rate.Mul(t))
+		af := gm1.Quo(rate)
+
+		y := pv.Mul(g).Add(pmt.Mul(fac).Mul(af)).Add(fv)
+		dy := pv.Mul(ng).Add(pmt.Mul(t).Mul(af)).Add(pmt.Mul(fac).Mul(ng.Sub(af).Quo(rate)))
+		return y, dy
+	})
+}
+
+// XIRR returns the internal rate of return of a series of cash flows made on
+// the provided dates, the annual rate at which their net present value is
+// zero, starting the search for the rate from guess. Each cash flow is
+// discounted by the number of days since the first date divided by 365. Only
+// the calendar date of each time is used. A guess of 0.1 is suitable for most
+// cash flows.
+//
+// Special cases are:
+//
+//	XIRR(values, dates, guess) = NaN if values and dates are different lengths
+//	XIRR(values, dates, guess) = NaN if any date is before the first date
+//	XIRR(values, dates, guess) = NaN if values does not contain both positive and negative values
+//	XIRR(values, dates, guess) = NaN if any argument is NaN or infinite
+//	XIRR(values, dates, guess) = NaN if the rate does not converge
+func XIRR(values []decimal128.Decimal, dates []time.Time, guess decimal128.Decimal) decimal128.Decimal {
+	if len(values) != len(dates) || !finite(values...) || !finite(guess) || !signChange(values) {
+		return decimal128.NaN()
+	}
+
+	days := decimal128.New(365, 0)
+	first := civilDay(dates[0])
+
+	times := make([]decimal128.Decimal, len(dates))
+	for i, date := range dates {
+		day := civilDay(date) - first
+		if day < 0 {
+			return decimal128.NaN()
+		}
+
+		times[i] = decimal128.New(day, 0).Quo(days)
+	}
+
+	return solve(guess, func(rate decimal128.Decimal) (decimal128.Decimal, decimal128.Decimal) {
+		// f(r) = Σ v[i] (1 + r)**-t[i]
+		// f'(r) = Σ -t[i] v[i] (1 + r)**(-t[i] - 1)
+		var f, df decimal128.Accumulator
+
+		lg := decimal128.Log1p(rate)
+		for i, v := range values {
+			d := v.Mul(decimal128.Exp(lg.Mul(times[i]).Neg()))
+
+			f.Add(d)
+			df.Add(d.Mul(times[i]).Neg())
+		}
+
+		y, _ := f.Result()
+		dy, _ := df.Result()
+		return y, dy.Quo(one.Add(rate))
+	})
+}
+
+// annuity returns pmt (1 + rate × when) ((1 + rate)**nper - 1) / rate, given
+// gm1 = (1 + rate)**nper - 1.
+func annuity(rate, gm1, pmt decimal128.Decimal, when Timing) decimal128.Decimal {
+	res := pmt.Mul(gm1.Quo(rate))
+	if when == StartOfPeriod {
+		res = res.Mul(one.Add(rate))
+	}
+
+	return res
+}
+
+func civilDay(t time.Time) int64 {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix() / 86400
+}
+
+func finite(values ...decimal128.Decimal) bool {
+	for _, d := range values {
+		if d.IsNaN() || d.IsInf(0) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// growth returns (1 + rate)**nper and (1 + rate)**nper - 1.
+func growth(rate, nper decimal128.Decimal) (decimal128.Decimal, decimal128.Decimal) {
+	gm1 := decimal128.Expm1(nper.Mul(decimal128.Log1p(rate)))
+	return gm1.Add(one), gm1
+}
+
+func signChange(values []decimal128.Decimal) bool {
+	var pos, neg bool
+	for _, v := range values {
+		switch v.Sign() {
+		case 1:
+			pos = true
+		case -1:
+			neg = true
+		}
+	}
+
+	return pos && neg
+}
+
+// solve finds a root of f, which returns both its value and its derivative,
+// using Newton's method. If an estimate falls at or below -1, where the rate
+// functions are undefined, the estimate is instead moved halfway towards -1.
+func solve(guess decimal128.Decimal, f func(decimal128.Decimal) (decimal128.Decimal, decimal128.Decimal)) decimal128.Decimal {
+	minusOne := one.Neg()
+	half := decimal128.New(5, -1)
+
+	rate := guess
+	for range maxIterations {
+		y, dy := f(rate)
+		if y.IsZero() {
+			return rate
+		}
+
+		step := y.Quo(dy)
+		if !finite(step) {
+			return decimal128.NaN()
+		}
+
+		next := rate.Sub(step)
+		if next.Cmp(minusOne).LessOrEqual() {
+			rate = rate.Add(minusOne).Mul(half)
+			continue
+		}
+
+		if decimal128.Abs(next.Sub(rate)).Cmp(tolerance.Mul(decimal128.Max(one, decimal128.Abs(next)))).LessOrEqual() {
+			return next
+		}
+
+		rate = next
+	}
+
+	return decimal128.NaN()
+}
+
+func validRate(rate decimal128.Decimal) bool {
+	return rate.Cmp(one.Neg()).Greater()
+}
@@ -0,0 +1,280 @@
+package finance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/woodsbury/decimal128"
+)
+
+func closeTo(x decimal128.Decimal, want string) bool {
+	w := decimal128.MustParse(want)
+
+	if w.IsNaN() {
+		return x.IsNaN()
+	}
+
+	diff := decimal128.Abs(x.Sub(w))
+	return diff.Cmp(decimal128.Abs(w).Mul(decimal128.New(1, -28))).LessOrEqual()
+}
+
+func decimals(values ...string) []decimal128.Decimal {
+	res := make([]decimal128.Decimal, len(values))
+	for i, val := range values {
+		res[i] = decimal128.MustParse(val)
+	}
+
+	return res
+}
+
+func TestFV(t *testing.T) {
+	t.Parallel()
+
+	values := []struct {
+		rate string
+		nper string
+		pmt  string
+		pv   string
+		when Timing
+		res  string
+	}{
+		{"0.005", "10", "-200", "-500", StartOfPeriod, "2581.4033740601791537250068359375"},
+		{"0.005", "10", "-200", "-500", EndOfPeriod, "2571.1753476520210252054736328125"},
+		{"1e-12", "360", "-100", "0", EndOfPeriod, "36000.00000646200000077113200006882"},
+		{"0", "12", "-100", "-1000", EndOfPeriod, "2200"},
+		{"-1", "12", "-100", "-1000", EndOfPeriod, "NaN"},
+		{"0.01", "NaN", "-100", "-1000", EndOfPeriod, "NaN"},
+		{"0.01", "12", "Inf", "-1000", EndOfPeriod, "NaN"},
+	}
+
+	for _, val := range values {
+		rate := decimal128.MustParse(val.rate)
+		nper := decimal128.MustParse(val.nper)
+		pmt := decimal128.MustParse(val.pmt)
+		pv := decimal128.MustParse(val.pv)
+
+		if res := FV(rate, nper, pmt, pv, val.when); !closeTo(res, val.res) {
+			t.Errorf("FV(%v, %v, %v, %v, %v) = %v, want %s", rate, nper, pmt, pv, val.when, res, val.res)
+		}
+	}
+}
+
+func TestIRR(t *testing.T) {
+	t.Parallel()
+
+	values := []struct {
+		values []decimal128.Decimal
+		guess  string
+		res    string
+	}{
+		{decimals("-70000", "12000", "15000", "18000", "21000", "26000"), "0.1", "0.08663094803653161429309420250847772"},
+		{decimals("-70000", "12000", "15000", "18000", "21000", "26000"), "-0.5", "0.08663094803653161429309420250847772"},
+		{decimals("-100", "110"), "0", "0.1"},
+		{decimals("-100", "0", "121"), "0.1", "0.1"},
+		{decimals("100", "110"), "0.1", "NaN"},
+		{decimals("-100", "NaN"), "0.1", "NaN"},
+		{nil, "0.1", "NaN"},
+	}
+
+	for _, val := range values {
+		guess := decimal128.MustParse(val.guess)
+
+		if res := IRR(val.values, guess); !closeTo(res, val.res) {
+			t.Errorf("IRR(%v, %v) = %v, want %s", val.values, guess, res, val.res)
+		}
+	}
+}
+
+func TestNPV(t *testing.T) {
+	t.Parallel()
+
+	values := []struct {
+		rate   string
+		values []decimal128.Decimal
+		res    string
+	}{
+		{"0.1", decimals("-10000", "3000", "4200", "6800"), "1188.443412335223003893176695580903"},
+		{"0", decimals("-10000", "3000", "4200", "6800"), "4000"},
+		{"0.1", nil, "0"},
+		{"-1", decimals("1"), "NaN"},
+		{"0.1", decimals("1", "NaN"), "NaN"},
+	}
+
+	for _, val := range values {
+		rate := decimal128.MustParse(val.rate)
+
+		if res := NPV(rate, val.values); !closeTo(res, val.res) {
+			t.Errorf("NPV(%v, %v) = %v, want %s", rate, val.values, res, val.res)
+		}
+	}
+}
+
+func TestNPer(t *testing.T) {
+	t.Parallel()
+
+	values := []struct {
+		rate string
+		pmt  string
+		pv   string
+		fv   string
+		when Timing
+		res  string
+	}{
+		{"0.01", "-100", "-1000", "10000", StartOfPeriod, "59.67386567429462558750359043003702"},
+		{"0", "-100", "1000", "0", EndOfPeriod, "10"},
+		{"0", "0", "1000", "0", EndOfPeriod, "NaN"},
+		{"0.01", "100", "1000", "10000", EndOfPeriod, "NaN"},
+		{"-2", "-100", "1000", "0", EndOfPeriod, "NaN"},
+	}
+
+	for _, val := range values {
+		rate := decimal128.MustParse(val.rate)
+		pmt := decimal128.MustParse(val.pmt)
+		pv := decimal128.MustParse(val.pv)
+		fv := decimal128.MustParse(val.fv)
+
+		if res := NPer(rate, pmt, pv, fv, val.when); !closeTo(res, val.res) {
+			t.Errorf("NPer(%v, %v, %v, %v, %v) = %v, want %s", rate, pmt, pv, fv, val.when, res, val.res)
+		}
+	}
+}
+
+func TestPMT(t *testing.T) {
+	t.Parallel()
+
+	monthly := decimal128.MustParse("0.08").Quo(decimal128.New(12, 0)).String()
+
+	values := []struct {
+		rate string
+		nper string
+		pv   string
+		fv   string
+		when Timing
+		res  string
+	}{
+		{monthly, "10", "10000", "0", EndOfPeriod, "-1037.032089359152175652094829324283"},
+		{monthly, "10", "10000", "0", StartOfPeriod, "-1030.164327177965737402743207938029"},
+		{"0", "12", "1000", "0", EndOfPeriod, "-83.33333333333333333333333333333333"},
+		{"0.01", "0", "1000", "0", EndOfPeriod, "NaN"},
+		{"NaN", "12", "1000", "0", EndOfPeriod, "NaN"},
+	}
+
+	for _, val := range values {
+		rate := decimal128.MustParse(val.rate)
+		nper := decimal128.MustParse(val.nper)
+		pv := decimal128.MustParse(val.pv)
+		fv := decimal128.MustParse(val.fv)
+
+		if res := PMT(rate, nper, pv, fv, val.when); !closeTo(res, val.res) {
+			t.Errorf("PMT(%v, %v, %v, %v, %v) = %v, want %s", rate, nper, pv, fv, val.when, res, val.res)
+		}
+	}
+}
+
+func TestPV(t *testing.T) {
+	t.Parallel()
+
+	monthly := decimal128.MustParse("0.08").Quo(decimal128.New(12, 0)).String()
+
+	values := []struct {
+		rate string
+		nper string
+		pmt  string
+		fv   string
+		when Timing
+		res  string
+	}{
+		{monthly, "240", "500", "0", EndOfPeriod, "-59777.14585118802181680803884396358"},
+		{"0", "12", "-100", "0", EndOfPeriod, "1200"},
+		{"-1.5", "12", "-100", "0", EndOfPeriod, "NaN"},
+	}
+
+	for _, val := range values {
+		rate := decimal128.MustParse(val.rate)
+		nper := decimal128.MustParse(val.nper)
+		pmt := decimal128.MustParse(val.pmt)
+		fv := decimal128.MustParse(val.fv)
+
+		if res := PV(rate, nper, pmt, fv, val.when); !closeTo(res, val.res) {
+			t.Errorf("PV(%v, %v, %v, %v, %v) = %v, want %s", rate, nper, pmt, fv, val.when, res, val.res)
+		}
+	}
+}
+
+func TestRate(t *testing.T) {
+	t.Parallel()
+
+	values := []struct {
+		nper  string
+		pmt   string
+		pv    string
+		fv    string
+		when  Timing
+		guess string
+		res   string
+	}{
+		{"48", "-200", "8000", "0", EndOfPeriod, "0.1", "0.007701472488202043815969130104399992"},
+		{"48", "-200", "8000", "0", EndOfPeriod, "0", "0.007701472488202043815969130104399992"},
+		{"12", "-100", "1200", "0", EndOfPeriod, "0.1", "0"},
+		{"10", "0", "-1000", "2000", EndOfPeriod, "0.1", "0.07177346253629316421300632502334202"},
+		{"0", "-100", "1200", "0", EndOfPeriod, "0.1", "NaN"},
+		{"12", "100", "1200", "0", EndOfPeriod, "0.1", "NaN"},
+	}
+
+	for _, val := range values {
+		nper := decimal128.MustParse(val.nper)
+		pmt := decimal128.MustParse(val.pmt)
+		pv := decimal128.MustParse(val.pv)
+		fv := decimal128.MustParse(val.fv)
+		guess := decimal128.MustParse(val.guess)
+
+		res := Rate(nper, pmt, pv, fv, val.when, guess)
+
+		// A zero rate can only be approached, so allow an absolute error.
+		if val.res == "0" {
+			if decimal128.Abs(res).Cmp(decimal128.New(1, -28)).Greater() {
+				t.Errorf("Rate(%v, %v, %v, %v, %v, %v) = %v, want 0", nper, pmt, pv, fv, val.when, guess, res)
+			}
+
+			continue
+		}
+
+		if !closeTo(res, val.res) {
+			t.Errorf("Rate(%v, %v, %v, %v, %v, %v) = %v, want %s", nper, pmt, pv, fv, val.when, guess, res, val.res)
+		}
+	}
+}
+
+func TestXIRR(t *testing.T) {
+	t.Parallel()
+
+	date := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 12, 0, 0, 0, time.UTC)
+	}
+
+	dates := []time.Time{
+		date(2008, time.January, 1),
+		date(2008, time.March, 1),
+		date(2008, time.October, 30),
+		date(2009, time.February, 15),
+		date(2009, time.April, 1),
+	}
+
+	values := []struct {
+		values []decimal128.Decimal
+		dates  []time.Time
+		res    string
+	}{
+		{decimals("-10000", "2750", "4250", "3250", "2750"), dates, "0.3733625335188315103084554119145548"},
+		{decimals("-100", "110"), []time.Time{date(2021, time.January, 1), date(2022, time.January, 1)}, "0.1"},
+		{decimals("-10000", "2750"), dates, "NaN"},
+		{decimals("10000", "2750"), dates[:2], "NaN"},
+		{decimals("-10000", "2750"), []time.Time{dates[1], dates[0]}, "NaN"},
+	}
+
+	for _, val := range values {
+		if res := XIRR(val.values, val.dates, decimal128.MustParse("0.1")); !closeTo(res, val.res) {
+			t.Errorf("XIRR(%v, %v, 0.1) = %v, want %s", val.values, val.dates, res, val.res)
+		}
+	}
+}
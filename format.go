@@ -6,13 +6,16 @@ import (
 )
 
 var (
-	nanText    = []byte("NaN")
-	padNaNText = []byte(" NaN")
-	posNaNText = []byte("+NaN")
-	negInfText = []byte("-Inf")
-	padInfText = []byte(" Inf")
-	posInfText = []byte("+Inf")
-	spaceText  = []byte{' ', ' ', ' '}
+	nanText     = []byte("NaN")
+	padNaNText  = []byte(" NaN")
+	posNaNText  = []byte("+NaN")
+	snanText    = []byte("sNaN")
+	padSNaNText = []byte(" sNaN")
+	posSNaNText = []byte("+sNaN")
+	negInfText  = []byte("-Inf")
+	padInfText  = []byte(" Inf")
+	posInfText  = []byte("+Inf")
+	spaceText   = []byte{' ', ' ', ' '}
 
 	digitPairs = [...][2]byte{
 		{'0', '0'}, {'0', '1'}, {'0', '2'}, {'0', '3'}, {'0', '4'},
@@ -271,7 +274,15 @@ func (d Decimal) String() string {
 
 func (d Decimal) appendSpecial(buf []byte, width int, printSign, padSign, padRight bool) []byte {
 	var value []byte
-	if d.IsNaN() {
+	if d.IsSignalingNaN() {
+		if printSign {
+			value = posSNaNText
+		} else if padSign {
+			value = padSNaNText
+		} else {
+			value = snanText
+		}
+	} else if d.IsNaN() {
 		if printSign {
 			value = posNaNText
 		} else if padSign {
@@ -514,7 +525,15 @@ func (d Decimal) format(buf []byte, args *formatArgs) []byte {
 
 func (d Decimal) writeSpecial(f fmt.State, width int, printSign, padSign, padRight bool) {
 	var value []byte
-	if d.IsNaN() {
+	if d.IsSignalingNaN() {
+		if printSign {
+			value = posSNaNText
+		} else if padSign {
+			value = padSNaNText
+		} else {
+			value = snanText
+		}
+	} else if d.IsNaN() {
 		if printSign {
 			value = posNaNText
 		} else if padSign {
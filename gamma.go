@@ -0,0 +1,309 @@
+package decimal128
+
+import "math"
+
+// Gamma returns the gamma function of d, rounded using the
+// [DefaultRoundingMode].
+func Gamma(d Decimal) Decimal {
+	return GammaWithMode(d, DefaultRoundingMode)
+}
+
+// GammaWithMode returns the gamma function of d, rounded using the provided
+// rounding mode.
+//
+// If d is a positive integer small enough that the result fits in 34 digits,
+// the result is exact.
+//
+// Special cases are:
+//
+//	Gamma(+Inf) = +Inf
+//	Gamma(+0) = +Inf
+//	Gamma(-0) = -Inf
+//	Gamma(x) = NaN for integer x < 0
+//	Gamma(-Inf) = NaN
+//	Gamma(NaN) = NaN
+func GammaWithMode(d Decimal, mode RoundingMode) Decimal {
+	if d.isSpecial() {
+		if d.IsNaN() {
+			return d.quiet()
+		}
+
+		if d.Signbit() {
+			return nan(payloadOpGamma, payloadValNegInfinite, 0)
+		}
+
+		return inf(false)
+	}
+
+	dSig, dExp := d.decompose()
+	neg := d.Signbit()
+
+	if dSig[0]|dSig[1] == 0 {
+		return inf(neg)
+	}
+
+	dExp -= exponentBias
+	l10 := int(dExp) + dSig.log10()
+
+	intSig, intExp := dSig, dExp
+	for intExp < 0 {
+		sig, rem := intSig.div10()
+		if rem != 0 {
+			break
+		}
+
+		intSig = sig
+		intExp++
+	}
+
+	if intExp >= 0 {
+		if neg {
+			return nan(payloadOpGamma, payloadValNegFinite, 0)
+		}
+
+		if l10 < 2 && intSig[1] == 0 {
+			n := intSig[0]
+			for range intExp {
+				n *= 10
+			}
+
+			// Γ(n) = (n - 1)!, which can be calculated exactly while it fits
+			// in 128 bits once trailing zeros are removed.
+			res := uint128{1, 0}
+			exp := int16(exponentBias)
+
+			for i := uint64(2); i < n; i++ {
+				if res[1] > math.MaxUint64/i {
+					break
+				}
+
+				res = res.mul64(i)
+
+				for {
+					sig, rem := res.div10()
+					if rem != 0 {
+						break
+					}
+
+					res = sig
+					exp++
+				}
+
+				if i == n-1 {
+					// Restore as many of the removed zeros as fit, so
+					// that the result is an integer where possible.
+					for exp > exponentBias && res.log10() < maxDigits-2 {
+						res = res.mul64(10)
+						exp--
+					}
+
+					sig, exp := mode.reduce128(false, res, exp, 0)
+					return compose(false, sig, exp)
+				}
+			}
+
+			if n <= 2 {
+				return one(false)
+			}
+		}
+	}
+
+	val := decomposed192{
+		sig: uint192{dSig[0], dSig[1], 0},
+		exp: dExp,
+	}
+
+	var resNeg bool
+	var res decomposed192
+	var trunc int8
+
+	if neg && l10 >= 1 {
+		// Γ(x) is positive in (-2k, -2k + 1) and negative in (-2k - 1, -2k).
+		ip, frc := dSig.div(uint128PowersOf10[-dExp])
+		resNeg = ip[0]&1 == 0
+
+		if l10 >= 4 {
+			return zero(resNeg)
+		}
+
+		// Γ(x) = π / (|sin(πx)| * Γ(1 - x))
+		sin, lg, _ := val.gammaReflect(decomposed192{
+			sig: uint192{frc[0], frc[1], 0},
+			exp: dExp,
+		})
+
+		res, trunc = lg.epow(int16(lg.sig.log10()), 0)
+
+		if res.exp > maxUnbiasedExponent+116 {
+			return zero(resNeg)
+		}
+
+		res, trunc = res.mul(sin, trunc)
+		pi, _ := halfPi.add(halfPi, 0)
+		res, trunc = pi.quo(res, -trunc)
+	} else {
+		if l10 >= 4 {
+			return inf(false)
+		}
+
+		var prd decomposed192
+		resNeg, prd, res = val.lgammaShift(neg)
+		res, _ = res.lgamma()
+		res, trunc = res.epow(int16(res.sig.log10()), 0)
+
+		if res.exp > maxUnbiasedExponent+58 {
+			return inf(false)
+		}
+
+		res, trunc = res.quo(prd, trunc)
+	}
+
+	sig, exp := mode.reduce192(resNeg, res.sig, res.exp+exponentBias, trunc)
+
+	if exp > maxBiasedExponent {
+		return inf(resNeg)
+	}
+
+	return compose(resNeg, sig, exp)
+}
+
+// Lgamma returns the natural logarithm and sign (either -1 or +1) of
+// Gamma(d), rounded using the [DefaultRoundingMode].
+func Lgamma(d Decimal) (Decimal, int) {
+	return LgammaWithMode(d, DefaultRoundingMode)
+}
+
+// LgammaWithMode returns the natural logarithm and sign (either -1 or +1) of
+// Gamma(d), rounded using the provided rounding mode.
+//
+// For negative d close to a point where |Gamma(d)| = 1 the result is close to
+// zero, and only its absolute error is below 10**-40 before rounding.
+//
+// Special cases are:
+//
+//	Lgamma(+Inf) = +Inf
+//	Lgamma(0) = +Inf
+//	Lgamma(x) = +Inf for integer x < 0
+//	Lgamma(-Inf) = +Inf
+//	Lgamma(NaN) = NaN
+func LgammaWithMode(d Decimal, mode RoundingMode) (Decimal, int) {
+	if d.isSpecial() {
+		if d.IsNaN() {
+			return d.quiet(), 1
+		}
+
+		return inf(false), 1
+	}
+
+	dSig, dExp := d.decompose()
+	neg := d.Signbit()
+
+	if dSig[0]|dSig[1] == 0 {
+		if neg {
+			return inf(false), -1
+		}
+
+		return inf(false), 1
+	}
+
+	dExp -= exponentBias
+	l10 := int(dExp) + dSig.log10()
+
+	val := decomposed192{
+		sig: uint192{dSig[0], dSig[1], 0},
+		exp: dExp,
+	}
+
+	var gammaNeg bool
+	var resNeg bool
+	var res decomposed192
+	var trunc int8
+
+	if neg {
+		if dExp >= 0 {
+			return inf(false), 1
+		}
+
+		// Γ(x) is positive in (-2k, -2k + 1) and negative in (-2k - 1, -2k).
+		frc := dSig
+		gammaNeg = true
+		if -dExp < int16(len(uint128PowersOf10)) {
+			var ip uint128
+			ip, frc = dSig.div(uint128PowersOf10[-dExp])
+			if frc[0]|frc[1] == 0 {
+				return inf(false), 1
+			}
+
+			gammaNeg = ip[0]&1 == 0
+		}
+
+		if l10 >= 1 {
+			// log|Γ(x)| = log(π / |sin(πx)|) - log(Γ(1 - x))
+			sin, lg, _ := val.gammaReflect(decomposed192{
+				sig: uint192{frc[0], frc[1], 0},
+				exp: dExp,
+			})
+
+			pi, _ := halfPi.add(halfPi, 0)
+			sin, _ = pi.quo(sin, 0)
+			_, sin, trunc = sin.log()
+			resNeg, res, trunc = sin.sub(lg, trunc)
+
+			sig, exp := mode.reduce192(resNeg, res.sig, res.exp+exponentBias, trunc)
+
+			if exp > maxBiasedExponent {
+				return inf(resNeg), -1
+			}
+
+			if gammaNeg {
+				return compose(resNeg, sig, exp), -1
+			}
+
+			return compose(resNeg, sig, exp), 1
+		}
+	} else if l10 == 0 || l10 == -1 {
+		// log(Γ(x)) is zero at 1 and 2, so calculate it directly from a series
+		// near those points to avoid cancellation.
+		_, z1, _ := val.sub1(0)
+		_, z2, _ := val.sub(decomposed192{
+			sig: uint192{2, 0, 0},
+			exp: 0,
+		}, 0)
+
+		if z1.sig == (uint192{}) || z2.sig == (uint192{}) {
+			return zero(false), 1
+		}
+
+		if int(z1.exp)+z1.sig.log10() < -2 {
+			resNeg, res, trunc = val.lgammaNearOne(false)
+		} else if int(z2.exp)+z2.sig.log10() < -2 {
+			resNeg, res, trunc = val.lgammaNearOne(true)
+		}
+	}
+
+	if res.sig == (uint192{}) {
+		// log|Γ(x)| = log(Γ(x + n)) - log|x * (x + 1) * ... * (x + n - 1)|
+		var prd decomposed192
+		gammaNeg, prd, res = val.lgammaShift(neg)
+		res, trunc = res.lgamma()
+
+		if lpNeg, lp, _ := prd.log(); lpNeg {
+			res, trunc = res.add(lp, trunc)
+		} else {
+			resNeg, res, trunc = res.sub(lp, trunc)
+		}
+	}
+
+	sign := 1
+	if gammaNeg {
+		sign = -1
+	}
+
+	sig, exp := mode.reduce192(resNeg, res.sig, res.exp+exponentBias, trunc)
+
+	if exp > maxBiasedExponent {
+		return inf(resNeg), sign
+	}
+
+	return compose(resNeg, sig, exp), sign
+}
@@ -0,0 +1,54 @@
+package decimal128
+
+import "testing"
+
+func TestGamma(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var res Decimal
+
+	for r.scan("gamma(%v) = %v\n", &val, &res) {
+		gamma := Gamma(val)
+
+		if !resultEqual(gamma, res) {
+			t.Errorf("Gamma(%v) = %v, want %v", val, gamma, res)
+		}
+
+		lo := GammaWithMode(val, ToNegativeInf)
+		hi := GammaWithMode(val, ToPositiveInf)
+
+		if !res.IsNaN() && (lo.Cmp(res).Greater() || hi.Cmp(res).Less()) {
+			t.Errorf("GammaWithMode(%v, ToNegativeInf/ToPositiveInf) = [%v, %v], want interval containing %v", val, lo, hi, res)
+		}
+	}
+}
+
+func TestLgamma(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var res Decimal
+	var sign int
+
+	for r.scan("lgamma(%v) = %v %d\n", &val, &res, &sign) {
+		lgamma, lsign := Lgamma(val)
+
+		if !resultEqual(lgamma, res) || lsign != sign {
+			t.Errorf("Lgamma(%v) = %v, %d, want %v, %d", val, lgamma, lsign, res, sign)
+		}
+
+		lo, _ := LgammaWithMode(val, ToNegativeInf)
+		hi, _ := LgammaWithMode(val, ToPositiveInf)
+
+		if !res.IsNaN() && (lo.Cmp(res).Greater() || hi.Cmp(res).Less()) {
+			t.Errorf("LgammaWithMode(%v, ToNegativeInf/ToPositiveInf) = [%v, %v], want interval containing %v", val, lo, hi, res)
+		}
+	}
+}
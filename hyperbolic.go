@@ -0,0 +1,427 @@
+package decimal128
+
+// Acosh returns the inverse hyperbolic cosine of d, rounded using the
+// [DefaultRoundingMode].
+func Acosh(d Decimal) Decimal {
+	return AcoshWithMode(d, DefaultRoundingMode)
+}
+
+// AcoshWithMode returns the inverse hyperbolic cosine of d, rounded using the
+// provided rounding mode.
+//
+// If d is NaN or less than 1, the result is NaN.
+func AcoshWithMode(d Decimal, mode RoundingMode) Decimal {
+	if d.isSpecial() {
+		if d.IsNaN() {
+			return d.quiet()
+		}
+
+		if d.Signbit() {
+			return nan(payloadOpAcosh, payloadValNegInfinite, 0)
+		}
+
+		return inf(false)
+	}
+
+	if d.Signbit() || d.IsZero() {
+		return nan(payloadOpAcosh, payloadVal(d), 0)
+	}
+
+	dSig, dExp := d.decompose()
+	dExp -= exponentBias
+	l10 := dSig.log10()
+
+	val := decomposed192{
+		sig: uint192{dSig[0], dSig[1], 0},
+		exp: dExp,
+	}
+
+	if int(dExp)+l10 >= 30 {
+		// acosh(x) = log(2x) - 1/(4x**2) - ..., where the remaining terms
+		// are too small to affect the result.
+		val, _ = val.add(val, 0)
+		_, res, trunc := val.log()
+		if trunc == 0 {
+			trunc = -1
+		}
+
+		sig, exp := mode.reduce192(false, res.sig, res.exp+exponentBias, trunc)
+		return compose(false, sig, exp)
+	}
+
+	lt1, t, _ := val.sub1(0)
+	if lt1 {
+		return nan(payloadOpAcosh, payloadVal(d), 0)
+	}
+
+	if t.sig[0]|t.sig[1]|t.sig[2] == 0 {
+		return zero(false)
+	}
+
+	// acosh(1 + t) = log1p(t + sqrt(t * (t + 2)))
+	tmp, trunc := t.add(decomposed192{
+		sig: uint192{2, 0, 0},
+		exp: 0,
+	}, 0)
+
+	tmp, trunc = tmp.mul(t, trunc)
+	tmp, trunc = tmp.sqrt()
+	tmp, trunc = tmp.add(t, trunc)
+	res, trunc := tmp.log1pPos(trunc)
+
+	sig, exp := mode.reduce192(false, res.sig, res.exp+exponentBias, trunc)
+	return compose(false, sig, exp)
+}
+
+// Asinh returns the inverse hyperbolic sine of d, rounded using the
+// [DefaultRoundingMode].
+func Asinh(d Decimal) Decimal {
+	return AsinhWithMode(d, DefaultRoundingMode)
+}
+
+// AsinhWithMode returns the inverse hyperbolic sine of d, rounded using the
+// provided rounding mode.
+func AsinhWithMode(d Decimal, mode RoundingMode) Decimal {
+	if d.isSpecial() {
+		if d.IsNaN() {
+			return d.quiet()
+		}
+
+		return d
+	}
+
+	dSig, dExp := d.decompose()
+
+	if dSig[0]|dSig[1] == 0 {
+		return d
+	}
+
+	neg := d.Signbit()
+	dExp -= exponentBias
+	l10 := dSig.log10()
+
+	if int(dExp)+l10 < -20 {
+		// asinh(x) = x - x**3/6 + ..., which is indistinguishable from x at
+		// this precision, but always has a slightly smaller magnitude.
+		sig, exp := mode.reduce128(neg, dSig, dExp+exponentBias, -1)
+		return compose(neg, sig, exp)
+	}
+
+	val := decomposed192{
+		sig: uint192{dSig[0], dSig[1], 0},
+		exp: dExp,
+	}
+
+	var res decomposed192
+	var trunc int8
+
+	if int(dExp)+l10 >= 30 {
+		// asinh(x) = log(2x) + 1/(4x**2) - ..., where the remaining terms
+		// are too small to affect the result.
+		val, _ = val.add(val, 0)
+		_, res, trunc = val.log()
+		if trunc == 0 {
+			trunc = 1
+		}
+	} else {
+		// asinh(x) = log1p(x + x**2 / (1 + sqrt(1 + x**2)))
+		sqr, _ := val.pow2(0)
+		tmp, _ := sqr.add1(0)
+		tmp, trunc = tmp.sqrt()
+		tmp, trunc = tmp.add1(trunc)
+		tmp, trunc = sqr.quo(tmp, trunc)
+		tmp, trunc = tmp.add(val, trunc)
+		res, trunc = tmp.log1pPos(trunc)
+	}
+
+	sig, exp := mode.reduce192(neg, res.sig, res.exp+exponentBias, trunc)
+	return compose(neg, sig, exp)
+}
+
+// Atanh returns the inverse hyperbolic tangent of d, rounded using the
+// [DefaultRoundingMode].
+func Atanh(d Decimal) Decimal {
+	return AtanhWithMode(d, DefaultRoundingMode)
+}
+
+// AtanhWithMode returns the inverse hyperbolic tangent of d, rounded using the
+// provided rounding mode.
+//
+// If d is NaN or has a magnitude greater than 1, the result is NaN. If d is
+// ±1, the result is ±Inf.
+func AtanhWithMode(d Decimal, mode RoundingMode) Decimal {
+	if d.isSpecial() {
+		if d.IsNaN() {
+			return d.quiet()
+		}
+
+		return nan(payloadOpAtanh, payloadVal(d), 0)
+	}
+
+	dSig, dExp := d.decompose()
+
+	if dSig[0]|dSig[1] == 0 {
+		return d
+	}
+
+	neg := d.Signbit()
+	dExp -= exponentBias
+
+	if int(dExp)+dSig.log10() < -20 {
+		// atanh(x) = x + x**3/3 + ..., which is indistinguishable from x at
+		// this precision, but always has a slightly larger magnitude.
+		sig, exp := mode.reduce128(neg, dSig, dExp+exponentBias, 1)
+		return compose(neg, sig, exp)
+	}
+
+	val := decomposed192{
+		sig: uint192{dSig[0], dSig[1], 0},
+		exp: dExp,
+	}
+
+	lt1, omx, _ := val.sub1(0)
+	if !lt1 {
+		if omx.sig[0]|omx.sig[1]|omx.sig[2] != 0 {
+			return nan(payloadOpAtanh, payloadVal(d), 0)
+		}
+
+		return inf(neg)
+	}
+
+	// atanh(x) = log1p(2x / (1 - x)) / 2
+	tmp, _ := val.add(val, 0)
+	tmp, trunc := tmp.quo(omx, 0)
+	res, trunc := tmp.log1pPos(trunc)
+	res, trunc = res.mul(decomposed192{
+		sig: uint192{5, 0, 0},
+		exp: -1,
+	}, trunc)
+
+	sig, exp := mode.reduce192(neg, res.sig, res.exp+exponentBias, trunc)
+	return compose(neg, sig, exp)
+}
+
+// Cosh returns the hyperbolic cosine of d, rounded using the
+// [DefaultRoundingMode].
+func Cosh(d Decimal) Decimal {
+	return CoshWithMode(d, DefaultRoundingMode)
+}
+
+// CoshWithMode returns the hyperbolic cosine of d, rounded using the provided
+// rounding mode.
+func CoshWithMode(d Decimal, mode RoundingMode) Decimal {
+	if d.isSpecial() {
+		if d.IsNaN() {
+			return d.quiet()
+		}
+
+		return inf(false)
+	}
+
+	dSig, dExp := d.decompose()
+
+	if dSig[0]|dSig[1] == 0 {
+		return one(false)
+	}
+
+	dExp -= exponentBias
+	l10 := dSig.log10()
+
+	if int(dExp)+l10 < -20 {
+		// cosh(x) = 1 + x**2/2 + ..., which is indistinguishable from 1 at
+		// this precision, but always slightly larger.
+		sig, exp := mode.reduce128(false, uint128{1, 0}, exponentBias, 1)
+		return compose(false, sig, exp)
+	}
+
+	if int(dExp) > 5-l10 {
+		return inf(false)
+	}
+
+	val := decomposed192{
+		sig: uint192{dSig[0], dSig[1], 0},
+		exp: dExp,
+	}
+
+	var res decomposed192
+	var trunc int8
+
+	if int(dExp)+l10 < 0 {
+		// cosh(x) = 1 + (e**x - 1)**2 / (2 * e**x)
+		_, em1, etrunc := val.epowm1(false, int16(l10), 0)
+		ex, _ := em1.add1(0)
+		ex, _ = ex.add(ex, 0)
+		res, trunc = em1.pow2(etrunc)
+		res, trunc = res.quo(ex, trunc)
+		res, trunc = res.add1(trunc)
+	} else {
+		// cosh(x) = (e**x + e**-x) / 2
+		ex, etrunc := val.epow(int16(l10), 0)
+
+		if ex.exp > maxUnbiasedExponent+58 {
+			return inf(false)
+		}
+
+		tmp, _ := ex.rcp(0)
+		res, trunc = ex.add(tmp, etrunc)
+		res, trunc = res.mul(decomposed192{
+			sig: uint192{5, 0, 0},
+			exp: -1,
+		}, trunc)
+	}
+
+	sig, exp := mode.reduce192(false, res.sig, res.exp+exponentBias, trunc)
+
+	if exp > maxBiasedExponent {
+		return inf(false)
+	}
+
+	return compose(false, sig, exp)
+}
+
+// Sinh returns the hyperbolic sine of d, rounded using the
+// [DefaultRoundingMode].
+func Sinh(d Decimal) Decimal {
+	return SinhWithMode(d, DefaultRoundingMode)
+}
+
+// SinhWithMode returns the hyperbolic sine of d, rounded using the provided
+// rounding mode.
+func SinhWithMode(d Decimal, mode RoundingMode) Decimal {
+	if d.isSpecial() {
+		if d.IsNaN() {
+			return d.quiet()
+		}
+
+		return d
+	}
+
+	dSig, dExp := d.decompose()
+
+	if dSig[0]|dSig[1] == 0 {
+		return d
+	}
+
+	neg := d.Signbit()
+	dExp -= exponentBias
+	l10 := dSig.log10()
+
+	if int(dExp)+l10 < -20 {
+		// sinh(x) = x + x**3/6 + ..., which is indistinguishable from x at
+		// this precision, but always has a slightly larger magnitude.
+		sig, exp := mode.reduce128(neg, dSig, dExp+exponentBias, 1)
+		return compose(neg, sig, exp)
+	}
+
+	if int(dExp) > 5-l10 {
+		return inf(neg)
+	}
+
+	val := decomposed192{
+		sig: uint192{dSig[0], dSig[1], 0},
+		exp: dExp,
+	}
+
+	var res decomposed192
+	var trunc int8
+
+	if int(dExp)+l10 < 0 {
+		// sinh(x) = ((e**x - 1) + (e**x - 1) / e**x) / 2
+		_, em1, etrunc := val.epowm1(false, int16(l10), 0)
+		ex, _ := em1.add1(0)
+		tmp, _ := em1.quo(ex, 0)
+		res, trunc = em1.add(tmp, etrunc)
+	} else {
+		// sinh(x) = (e**x - e**-x) / 2
+		ex, etrunc := val.epow(int16(l10), 0)
+
+		if ex.exp > maxUnbiasedExponent+58 {
+			return inf(neg)
+		}
+
+		tmp, _ := ex.rcp(0)
+		_, res, trunc = ex.sub(tmp, etrunc)
+	}
+
+	res, trunc = res.mul(decomposed192{
+		sig: uint192{5, 0, 0},
+		exp: -1,
+	}, trunc)
+
+	sig, exp := mode.reduce192(neg, res.sig, res.exp+exponentBias, trunc)
+
+	if exp > maxBiasedExponent {
+		return inf(neg)
+	}
+
+	return compose(neg, sig, exp)
+}
+
+// Tanh returns the hyperbolic tangent of d, rounded using the
+// [DefaultRoundingMode].
+func Tanh(d Decimal) Decimal {
+	return TanhWithMode(d, DefaultRoundingMode)
+}
+
+// TanhWithMode returns the hyperbolic tangent of d, rounded using the provided
+// rounding mode.
+func TanhWithMode(d Decimal, mode RoundingMode) Decimal {
+	if d.isSpecial() {
+		if d.IsNaN() {
+			return d.quiet()
+		}
+
+		return one(d.Signbit())
+	}
+
+	dSig, dExp := d.decompose()
+
+	if dSig[0]|dSig[1] == 0 {
+		return d
+	}
+
+	neg := d.Signbit()
+	dExp -= exponentBias
+	l10 := dSig.log10()
+
+	if int(dExp)+l10 < -20 {
+		// tanh(x) = x - x**3/3 + ..., which is indistinguishable from x at
+		// this precision, but always has a slightly smaller magnitude.
+		sig, exp := mode.reduce128(neg, dSig, dExp+exponentBias, -1)
+		return compose(neg, sig, exp)
+	}
+
+	if int(dExp)+l10 >= 2 {
+		// tanh(x) = 1 - 2/(e**2x + 1), which is indistinguishable from 1 at
+		// this precision, but always slightly smaller.
+		sig, exp := mode.reduce128(neg, uint128{1, 0}, exponentBias, -1)
+		return compose(neg, sig, exp)
+	}
+
+	val := decomposed192{
+		sig: uint192{dSig[0], dSig[1], 0}.mul64(2),
+		exp: dExp,
+	}
+
+	two := decomposed192{
+		sig: uint192{2, 0, 0},
+		exp: 0,
+	}
+
+	_, em1, trunc := val.epowm1(false, int16(val.sig.log10()), 0)
+	den, _ := em1.add(two, 0)
+
+	var res decomposed192
+	if int(dExp)+l10 < 0 {
+		// tanh(x) = (e**2x - 1) / (e**2x + 1)
+		res, trunc = em1.quo(den, trunc)
+	} else {
+		// tanh(x) = 1 - 2 / (e**2x + 1), which avoids the numerator and
+		// denominator becoming indistinguishable for large x.
+		res, trunc = two.quo(den, 0)
+		_, res, trunc = res.sub1(trunc)
+	}
+
+	sig, exp := mode.reduce192(neg, res.sig, res.exp+exponentBias, trunc)
+	return compose(neg, sig, exp)
+}
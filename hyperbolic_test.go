@@ -0,0 +1,191 @@
+package decimal128
+
+import "testing"
+
+func TestAcosh(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var res Decimal
+
+	for r.scan("acosh(%v) = %v\n", &val, &res) {
+		acosh := Acosh(val)
+
+		if !resultEqual(acosh, res) {
+			t.Errorf("Acosh(%v) = %v, want %v", val, acosh, res)
+		}
+
+		lo := AcoshWithMode(val, ToNegativeInf)
+		hi := AcoshWithMode(val, ToPositiveInf)
+
+		if !res.IsNaN() && (lo.Cmp(res).Greater() || hi.Cmp(res).Less()) {
+			t.Errorf("AcoshWithMode(%v, ToNegativeInf/ToPositiveInf) = [%v, %v], want interval containing %v", val, lo, hi, res)
+		}
+	}
+}
+
+func TestAsinh(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var res Decimal
+
+	for r.scan("asinh(%v) = %v\n", &val, &res) {
+		asinh := Asinh(val)
+
+		if !resultEqual(asinh, res) {
+			t.Errorf("Asinh(%v) = %v, want %v", val, asinh, res)
+		}
+
+		lo := AsinhWithMode(val, ToNegativeInf)
+		hi := AsinhWithMode(val, ToPositiveInf)
+
+		if !res.IsNaN() && (lo.Cmp(res).Greater() || hi.Cmp(res).Less()) {
+			t.Errorf("AsinhWithMode(%v, ToNegativeInf/ToPositiveInf) = [%v, %v], want interval containing %v", val, lo, hi, res)
+		}
+	}
+}
+
+func TestAtanh(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var res Decimal
+
+	for r.scan("atanh(%v) = %v\n", &val, &res) {
+		atanh := Atanh(val)
+
+		if !resultEqual(atanh, res) {
+			t.Errorf("Atanh(%v) = %v, want %v", val, atanh, res)
+		}
+
+		lo := AtanhWithMode(val, ToNegativeInf)
+		hi := AtanhWithMode(val, ToPositiveInf)
+
+		if !res.IsNaN() && (lo.Cmp(res).Greater() || hi.Cmp(res).Less()) {
+			t.Errorf("AtanhWithMode(%v, ToNegativeInf/ToPositiveInf) = [%v, %v], want interval containing %v", val, lo, hi, res)
+		}
+	}
+}
+
+func TestCosh(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var res Decimal
+
+	for r.scan("cosh(%v) = %v\n", &val, &res) {
+		cosh := Cosh(val)
+
+		if !resultEqual(cosh, res) {
+			t.Errorf("Cosh(%v) = %v, want %v", val, cosh, res)
+		}
+
+		lo := CoshWithMode(val, ToNegativeInf)
+		hi := CoshWithMode(val, ToPositiveInf)
+
+		if !res.IsNaN() && (lo.Cmp(res).Greater() || hi.Cmp(res).Less()) {
+			t.Errorf("CoshWithMode(%v, ToNegativeInf/ToPositiveInf) = [%v, %v], want interval containing %v", val, lo, hi, res)
+		}
+	}
+}
+
+func TestSinh(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var res Decimal
+
+	for r.scan("sinh(%v) = %v\n", &val, &res) {
+		sinh := Sinh(val)
+
+		if !resultEqual(sinh, res) {
+			t.Errorf("Sinh(%v) = %v, want %v", val, sinh, res)
+		}
+
+		lo := SinhWithMode(val, ToNegativeInf)
+		hi := SinhWithMode(val, ToPositiveInf)
+
+		if !res.IsNaN() && (lo.Cmp(res).Greater() || hi.Cmp(res).Less()) {
+			t.Errorf("SinhWithMode(%v, ToNegativeInf/ToPositiveInf) = [%v, %v], want interval containing %v", val, lo, hi, res)
+		}
+	}
+}
+
+func TestTanh(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var res Decimal
+
+	for r.scan("tanh(%v) = %v\n", &val, &res) {
+		tanh := Tanh(val)
+
+		if !resultEqual(tanh, res) {
+			t.Errorf("Tanh(%v) = %v, want %v", val, tanh, res)
+		}
+
+		lo := TanhWithMode(val, ToNegativeInf)
+		hi := TanhWithMode(val, ToPositiveInf)
+
+		if !res.IsNaN() && (lo.Cmp(res).Greater() || hi.Cmp(res).Less()) {
+			t.Errorf("TanhWithMode(%v, ToNegativeInf/ToPositiveInf) = [%v, %v], want interval containing %v", val, lo, hi, res)
+		}
+	}
+}
+
+func TestTanhNearOne(t *testing.T) {
+	t.Parallel()
+
+	below := MustParse("0.9999999999999999999999999999999999")
+
+	for _, val := range []string{"40", "70", "100", "1e4", "-40", "-70", "-100", "-1e4"} {
+		d := MustParse(val)
+		neg := d.Signbit()
+
+		for _, mode := range roundingModes {
+			// 1 - |tanh(d)| is far below the spacing between values near 1, so
+			// only modes that round the magnitude down give the value below 1.
+			want := one(false)
+
+			switch mode {
+			case ToZero, ToZero05Up, ToOdd:
+				want = below
+			case ToNegativeInf:
+				if !neg {
+					want = below
+				}
+			case ToPositiveInf:
+				if neg {
+					want = below
+				}
+			}
+
+			if neg {
+				want = want.Neg()
+			}
+
+			if res := TanhWithMode(d, mode); !resultEqual(res, want) {
+				t.Errorf("TanhWithMode(%v, %v) = %v, want %v", d, mode, res, want)
+			}
+		}
+	}
+}
@@ -404,6 +404,14 @@ func (n uint192) add(o uint192) uint256 {
 	return uint256{r0, r1, r2, r3}
 }
 
+func (n uint192) add128(o uint128) uint192 {
+	r0, carry := bits.Add64(n[0], o[0], 0)
+	r1, carry := bits.Add64(n[1], o[1], carry)
+	r2 := n[2] + carry
+
+	return uint192{r0, r1, r2}
+}
+
 func (n uint192) add64(o uint64) uint192 {
 	r0, carry := bits.Add64(n[0], o, 0)
 	r1, carry := bits.Add64(n[1], 0, carry)
@@ -982,6 +990,17 @@ func (n uint384) String() string {
 	return string(buf[i:])
 }
 
+func (n uint384) add(o uint384) uint384 {
+	r0, carry := bits.Add64(n[0], o[0], 0)
+	r1, carry := bits.Add64(n[1], o[1], carry)
+	r2, carry := bits.Add64(n[2], o[2], carry)
+	r3, carry := bits.Add64(n[3], o[3], carry)
+	r4, carry := bits.Add64(n[4], o[4], carry)
+	r5, _ := bits.Add64(n[5], o[5], carry)
+
+	return uint384{r0, r1, r2, r3, r4, r5}
+}
+
 func (n uint384) div10() (uint384, uint64) {
 	var r5, r4, rem uint64
 	if n[5] < 10 {
@@ -1015,3 +1034,42 @@ func (n uint384) div1e19() (uint384, uint64) {
 
 	return uint384{r0, r1, r2, r3, r4, r5}, rem
 }
+
+func (n uint384) mul64(o uint64) uint384 {
+	s1, r0 := bits.Mul64(n[0], o)
+	t2, t1 := bits.Mul64(n[1], o)
+	u3, u2 := bits.Mul64(n[2], o)
+	v4, v3 := bits.Mul64(n[3], o)
+	w5, w4 := bits.Mul64(n[4], o)
+	x5 := n[5] * o
+
+	r1, carry := bits.Add64(s1, t1, 0)
+	r2, carry := bits.Add64(t2, u2, carry)
+	r3, carry := bits.Add64(u3, v3, carry)
+	r4, carry := bits.Add64(v4, w4, carry)
+	r5, _ := bits.Add64(w5, x5, carry)
+
+	return uint384{r0, r1, r2, r3, r4, r5}
+}
+
+func (n uint384) sub(o uint384) (uint384, uint) {
+	r0, borrow := bits.Sub64(n[0], o[0], 0)
+	r1, borrow := bits.Sub64(n[1], o[1], borrow)
+	r2, borrow := bits.Sub64(n[2], o[2], borrow)
+	r3, borrow := bits.Sub64(n[3], o[3], borrow)
+	r4, borrow := bits.Sub64(n[4], o[4], borrow)
+	r5, borrow := bits.Sub64(n[5], o[5], borrow)
+
+	return uint384{r0, r1, r2, r3, r4, r5}, uint(borrow)
+}
+
+func (n uint384) twos() uint384 {
+	r0, carry := bits.Add64(^n[0], 1, 0)
+	r1, carry := bits.Add64(^n[1], 0, carry)
+	r2, carry := bits.Add64(^n[2], 0, carry)
+	r3, carry := bits.Add64(^n[3], 0, carry)
+	r4, carry := bits.Add64(^n[4], 0, carry)
+	r5 := ^n[5] + carry
+
+	return uint384{r0, r1, r2, r3, r4, r5}
+}
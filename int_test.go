@@ -1359,6 +1359,34 @@ func TestUint256String(t *testing.T) {
 	}
 }
 
+func TestUint384Add(t *testing.T) {
+	t.Parallel()
+
+	initUintValues()
+
+	biglhs := new(big.Int)
+	bigrhs := new(big.Int)
+	tmpsum := new(big.Int)
+
+	for _, lhs := range uint384Values {
+		for _, rhs := range uint384Values {
+			sum := lhs.add(rhs)
+
+			uint384ToBig(lhs, biglhs)
+			uint384ToBig(rhs, bigrhs)
+			bigsum := biglhs.Add(biglhs, bigrhs)
+			if bigsum.BitLen() > 384 {
+				b := bigsum.Bytes()
+				bigsum.SetBytes(b[len(b)-48:])
+			}
+
+			if uint384ToBig(sum, tmpsum).Cmp(bigsum) != 0 {
+				t.Errorf("%v.add(%v) = %v, want %v", lhs, rhs, sum, bigsum)
+			}
+		}
+	}
+}
+
 func TestUint384Div10(t *testing.T) {
 	t.Parallel()
 
@@ -1406,3 +1434,107 @@ func TestUint384Div1e19(t *testing.T) {
 		}
 	}
 }
+
+func TestUint384Mul64(t *testing.T) {
+	t.Parallel()
+
+	initUintValues()
+
+	biglhs := new(big.Int)
+	bigrhs := new(big.Int)
+	tmpprd := new(big.Int)
+
+	for _, lhs := range uint384Values {
+		for _, rhs := range uint64Values {
+			prd := lhs.mul64(rhs)
+
+			uint384ToBig(lhs, biglhs)
+			bigrhs.SetUint64(rhs)
+			bigprd := biglhs.Mul(biglhs, bigrhs)
+			if bigprd.BitLen() > 384 {
+				b := bigprd.Bytes()
+				bigprd.SetBytes(b[len(b)-48:])
+			}
+
+			if uint384ToBig(prd, tmpprd).Cmp(bigprd) != 0 {
+				t.Errorf("%v.mul64(%d) = %v, want %v", lhs, rhs, prd, bigprd)
+			}
+		}
+	}
+}
+
+func TestUint384Sub(t *testing.T) {
+	t.Parallel()
+
+	initUintValues()
+
+	one := big.NewInt(1)
+
+	biglhs := new(big.Int)
+	bigrhs := new(big.Int)
+	tmpdif := new(big.Int)
+
+	for _, lhs := range uint384Values {
+		for _, rhs := range uint384Values {
+			dif, brw := lhs.sub(rhs)
+
+			uint384ToBig(lhs, biglhs)
+			uint384ToBig(rhs, bigrhs)
+			bigdif := biglhs.Sub(biglhs, bigrhs)
+			bigbrw := uint(0)
+			if bigdif.Sign() == -1 {
+				bigbrw = 1
+
+				b := make([]byte, 48)
+				c := bigdif.Bytes()
+				copy(b[48-len(c):], c)
+
+				for i := range b {
+					b[i] = ^b[i]
+				}
+
+				bigdif.SetBytes(b)
+				bigdif.Add(bigdif, one)
+			}
+
+			if uint384ToBig(dif, tmpdif).Cmp(bigdif) != 0 || brw != bigbrw {
+				t.Errorf("%v.sub(%v) = (%v, %v), want (%v, %v)", lhs, rhs, dif, brw, bigdif, bigbrw)
+			}
+		}
+	}
+}
+
+func TestUint384Twos(t *testing.T) {
+	t.Parallel()
+
+	initUintValues()
+
+	one := big.NewInt(1)
+
+	bigval := new(big.Int)
+	tmpres := new(big.Int)
+
+	for _, val := range uint384Values {
+		res := val.twos()
+
+		uint384ToBig(val, bigval)
+		b := make([]byte, 48)
+		c := bigval.Bytes()
+		copy(b[48-len(c):], c)
+
+		for i := range b {
+			b[i] = ^b[i]
+		}
+
+		bigval.SetBytes(b)
+		bigres := bigval.Add(bigval, one)
+		if bigres.BitLen() > 384 {
+			b = bigres.Bytes()
+			bigres.SetBytes(b[len(b)-48:])
+		}
+
+		if uint384ToBig(res, tmpres).Cmp(bigres) != 0 {
+			t.Errorf("%v.twos() = %v, want %v", val, res, bigres)
+		}
+	}
+}
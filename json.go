@@ -36,7 +36,9 @@ func (d Decimal) MarshalJSON() ([]byte, error) {
 	return digs.fmtF(nil, prec, 0, false, false, false, false, false), nil
 }
 
-// UnmarshalJSON implements the [encoding/json.Unmarshaler] interface.
+// UnmarshalJSON implements the [encoding/json.Unmarshaler] interface. Values
+// that are too precise to fit in a Decimal are rounded using the
+// [DefaultRoundingMode]; use [ParseWithMode] to choose a rounding mode instead.
 func (d *Decimal) UnmarshalJSON(data []byte) error {
 	if string(data) == "null" {
 		return nil
@@ -58,7 +60,7 @@ func (d *Decimal) UnmarshalJSON(data []byte) error {
 		i = 1
 	}
 
-	tmp, err := parseNumber(data[i:], neg, false)
+	tmp, err := parseNumber(data[i:], neg, false, DefaultRoundingMode)
 	if err != nil {
 		switch err := err.(type) {
 		case parseNumberRangeError:
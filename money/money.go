@@ -0,0 +1,322 @@
+// Package money provides a Money type that pairs a Decimal amount with an
+// ISO 4217 currency.
+//
+// Arithmetic between two Money values is only permitted when they share a
+// currency, and amounts can be rounded to the number of minor unit digits
+// used by their currency. Money values marshal to JSON as an object holding
+// the amount as a string, such as {"amount":"12.34","currency":"USD"}, and to
+// text as the amount followed by the currency code, such as "12.34 USD".
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/woodsbury/decimal128"
+)
+
+var (
+	// ErrCurrencyMismatch is returned when an operation is given Money values
+	// in different currencies.
+	ErrCurrencyMismatch = errors.New("money: currency mismatch")
+
+	// ErrUnknownCurrency is returned when a currency code is not a known
+	// ISO 4217 currency.
+	ErrUnknownCurrency = errors.New("money: unknown currency")
+)
+
+// Money is a Decimal amount in a particular currency. The zero value for Money
+// has no currency.
+type Money struct {
+	amount   decimal128.Decimal
+	currency Currency
+}
+
+// Compare compares m and o and returns -1 if m is less than o, 0 if they are
+// equal, and +1 if m is greater than o, ordering amounts as
+// [decimal128.Compare] does. If m and o are in different currencies, Compare
+// returns an error that can be compared to [ErrCurrencyMismatch] via
+// [errors.Is].
+func Compare(m, o Money) (int, error) {
+	if m.currency != o.currency {
+		return 0, &mismatchError{m.currency, o.currency}
+	}
+
+	return decimal128.Compare(m.amount, o.amount), nil
+}
+
+// New returns a Money value with the provided amount and currency. The amount
+// is not rounded.
+func New(amount decimal128.Decimal, currency Currency) Money {
+	return Money{amount: amount, currency: currency}
+}
+
+// Parse parses a Money value from an amount, using the syntax accepted by
+// [decimal128.Parse], and an ISO 4217 currency code. The amount is not
+// rounded. Parse returns an error if the amount is not a finite number or the
+// currency is not known.
+func Parse(amount, currency string) (Money, error) {
+	c, err := ParseCurrency(currency)
+	if err != nil {
+		return Money{}, err
+	}
+
+	d, err := decimal128.Parse(amount)
+	if err != nil {
+		return Money{}, err
+	}
+
+	if d.IsNaN() || d.IsInf(0) {
+		return Money{}, &amountError{s: amount}
+	}
+
+	return Money{amount: d, currency: c}, nil
+}
+
+// Abs returns the absolute value of m.
+func (m Money) Abs() Money {
+	return Money{amount: decimal128.Abs(m.amount), currency: m.currency}
+}
+
+// Add adds m and o and returns the result. The result is not rounded to the
+// minor units of the currency. If m and o are in different currencies, Add
+// returns an error that can be compared to [ErrCurrencyMismatch] via
+// [errors.Is].
+func (m Money) Add(o Money) (Money, error) {
+	if m.currency != o.currency {
+		return Money{}, &mismatchError{m.currency, o.currency}
+	}
+
+	return Money{amount: m.amount.Add(o.amount), currency: m.currency}, nil
+}
+
+// Allocate splits m into parts proportional to ratios, using
+// [decimal128.Allocate] with the minor units of the currency, such that the
+// parts always sum exactly to m. Allocate returns nil if m is not exactly
+// representable in the minor units of its currency, or if ratios are invalid.
+func (m Money) Allocate(ratios []decimal128.Decimal) []Money {
+	amounts := decimal128.Allocate(m.amount, ratios, m.currency.MinorUnits())
+	if amounts == nil {
+		return nil
+	}
+
+	res := make([]Money, len(amounts))
+	for i, amount := range amounts {
+		res[i] = Money{amount: amount, currency: m.currency}
+	}
+
+	return res
+}
+
+// Amount returns the amount of m.
+func (m Money) Amount() decimal128.Decimal {
+	return m.amount
+}
+
+// Currency returns the currency of m.
+func (m Money) Currency() Currency {
+	return m.currency
+}
+
+// Equal reports whether m and o are in the same currency and have equal
+// amounts.
+func (m Money) Equal(o Money) bool {
+	return m.currency == o.currency && m.amount.Equal(o.amount)
+}
+
+// IsZero reports whether the amount of m is zero.
+func (m Money) IsZero() bool {
+	return m.amount.IsZero()
+}
+
+// MarshalJSON implements the [encoding/json.Marshaler] interface.
+func (m Money) MarshalJSON() ([]byte, error) {
+	if err := m.validate(); err != nil {
+		return nil, err
+	}
+
+	buf := append([]byte(nil), `{"amount":"`...)
+	buf = m.appendAmount(buf)
+	buf = append(buf, `","currency":"`...)
+	buf = append(buf, m.currency.code...)
+	buf = append(buf, `"}`...)
+
+	return buf, nil
+}
+
+// MarshalText implements the [encoding.TextMarshaler] interface.
+func (m Money) MarshalText() ([]byte, error) {
+	if err := m.validate(); err != nil {
+		return nil, err
+	}
+
+	return m.appendText(nil), nil
+}
+
+// Mul multiplies the amount of m by d and returns the result. The result is not
+// rounded to the minor units of the currency.
+func (m Money) Mul(d decimal128.Decimal) Money {
+	return Money{amount: m.amount.Mul(d), currency: m.currency}
+}
+
+// Neg returns m with the sign of its amount negated.
+func (m Money) Neg() Money {
+	return Money{amount: m.amount.Neg(), currency: m.currency}
+}
+
+// Quo divides the amount of m by d and returns the result. The result is not
+// rounded to the minor units of the currency.
+func (m Money) Quo(d decimal128.Decimal) Money {
+	return Money{amount: m.amount.Quo(d), currency: m.currency}
+}
+
+// Round rounds the amount of m to the minor units of its currency using the
+// provided rounding mode, so that it always has exactly that many digits after
+// the decimal point. If the amount is too large to be represented with that
+// many digits, the result is NaN, as for [decimal128.Decimal.Rescale].
+func (m Money) Round(mode decimal128.RoundingMode) Money {
+	return Money{amount: m.amount.Rescale(m.currency.MinorUnits(), mode), currency: m.currency}
+}
+
+// Sign returns:
+//
+//	-1 if the amount of m < 0
+//	 0 if the amount of m == 0
+//	+1 if the amount of m > 0
+func (m Money) Sign() int {
+	return m.amount.Sign()
+}
+
+// String returns the amount of m followed by its currency code, such as
+// "12.34 USD". The amount is shown with at least as many digits after the
+// decimal point as the minor units of the currency, and a zero amount is shown
+// with exactly that many.
+func (m Money) String() string {
+	return string(m.appendText(nil))
+}
+
+// Sub subtracts o from m and returns the result. The result is not rounded to
+// the minor units of the currency. If m and o are in different currencies, Sub
+// returns an error that can be compared to [ErrCurrencyMismatch] via
+// [errors.Is].
+func (m Money) Sub(o Money) (Money, error) {
+	if m.currency != o.currency {
+		return Money{}, &mismatchError{m.currency, o.currency}
+	}
+
+	return Money{amount: m.amount.Sub(o.amount), currency: m.currency}, nil
+}
+
+// UnmarshalJSON implements the [encoding/json.Unmarshaler] interface. The
+// amount may be either a string or a JSON number.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var obj struct {
+		Amount   json.RawMessage `json:"amount"`
+		Currency string          `json:"currency"`
+	}
+
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+
+	amount := string(obj.Amount)
+	if strings.HasPrefix(amount, `"`) {
+		var err error
+		amount, err = strconv.Unquote(amount)
+		if err != nil {
+			return &amountError{s: string(obj.Amount)}
+		}
+	}
+
+	res, err := Parse(amount, obj.Currency)
+	if err != nil {
+		return err
+	}
+
+	*m = res
+	return nil
+}
+
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface. It accepts
+// an amount followed by a currency code, separated by a space, as produced by
+// [Money.MarshalText].
+func (m *Money) UnmarshalText(data []byte) error {
+	amount, currency, ok := strings.Cut(string(data), " ")
+	if !ok {
+		return &amountError{s: string(data)}
+	}
+
+	res, err := Parse(amount, currency)
+	if err != nil {
+		return err
+	}
+
+	*m = res
+	return nil
+}
+
+func (m Money) appendAmount(buf []byte) []byte {
+	prec := m.currency.MinorUnits()
+	if !m.amount.IsNaN() && !m.amount.IsInf(0) && !m.amount.IsZero() {
+		prec = max(prec, m.amount.Scale())
+	}
+
+	return decimal128.Append(buf, m.amount, 'f', prec)
+}
+
+func (m Money) appendText(buf []byte) []byte {
+	buf = m.appendAmount(buf)
+	buf = append(buf, ' ')
+	return append(buf, m.currency.code...)
+}
+
+func (m Money) validate() error {
+	if m.currency == (Currency{}) {
+		return &currencyError{}
+	}
+
+	if m.amount.IsNaN() || m.amount.IsInf(0) {
+		return &amountError{s: m.amount.String()}
+	}
+
+	return nil
+}
+
+type amountError struct {
+	s string
+}
+
+func (err *amountError) Error() string {
+	return "money: invalid amount " + strconv.Quote(err.s)
+}
+
+type currencyError struct {
+	code string
+}
+
+func (err *currencyError) Error() string {
+	return "money: unknown currency " + strconv.Quote(err.code)
+}
+
+func (err *currencyError) Is(target error) bool {
+	return target == ErrUnknownCurrency
+}
+
+type mismatchError struct {
+	lhs Currency
+	rhs Currency
+}
+
+func (err *mismatchError) Error() string {
+	return "money: currency mismatch between " + strconv.Quote(err.lhs.code) + " and " + strconv.Quote(err.rhs.code)
+}
+
+func (err *mismatchError) Is(target error) bool {
+	return target == ErrCurrencyMismatch
+}
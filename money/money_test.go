@@ -0,0 +1,270 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/woodsbury/decimal128"
+)
+
+func TestAllocate(t *testing.T) {
+	t.Parallel()
+
+	m := New(decimal128.MustParse("100"), MustParseCurrency("USD"))
+	ratios := []decimal128.Decimal{decimal128.New(1, 0), decimal128.New(1, 0), decimal128.New(1, 0)}
+	res := m.Allocate(ratios)
+	want := []string{"33.34 USD", "33.33 USD", "33.33 USD"}
+
+	if len(res) != len(want) {
+		t.Fatalf("%v.Allocate(%v) = %v, want %v", m, ratios, res, want)
+	}
+
+	for i := range res {
+		if res[i].String() != want[i] {
+			t.Errorf("%v.Allocate(%v) = %v, want %v", m, ratios, res, want)
+		}
+	}
+
+	m = New(decimal128.MustParse("0.5"), MustParseCurrency("JPY"))
+	if res := m.Allocate(ratios); res != nil {
+		t.Errorf("%v.Allocate(%v) = %v, want nil", m, ratios, res)
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	t.Parallel()
+
+	usd := MustParseCurrency("USD")
+	eur := MustParseCurrency("EUR")
+
+	x := New(decimal128.MustParse("12.34"), usd)
+	y := New(decimal128.MustParse("0.66"), usd)
+	z := New(decimal128.MustParse("1"), eur)
+
+	if res, err := x.Add(y); err != nil || res.String() != "13.00 USD" {
+		t.Errorf("%v.Add(%v) = (%v, %v), want (13.00 USD, <nil>)", x, y, res, err)
+	}
+
+	if res, err := x.Sub(y); err != nil || res.String() != "11.68 USD" {
+		t.Errorf("%v.Sub(%v) = (%v, %v), want (11.68 USD, <nil>)", x, y, res, err)
+	}
+
+	if res, err := x.Sub(x); err != nil || res.String() != "0.00 USD" {
+		t.Errorf("%v.Sub(%v) = (%v, %v), want (0.00 USD, <nil>)", x, x, res, err)
+	}
+
+	if res, err := Compare(x, y); err != nil || res != 1 {
+		t.Errorf("Compare(%v, %v) = (%d, %v), want (1, <nil>)", x, y, res, err)
+	}
+
+	if _, err := x.Add(z); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("%v.Add(%v) error = %v, want ErrCurrencyMismatch", x, z, err)
+	}
+
+	if _, err := x.Sub(z); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("%v.Sub(%v) error = %v, want ErrCurrencyMismatch", x, z, err)
+	}
+
+	if _, err := Compare(x, z); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Compare(%v, %v) error = %v, want ErrCurrencyMismatch", x, z, err)
+	}
+
+	if _, err := x.Add(Money{}); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("%v.Add(Money{}) error = %v, want ErrCurrencyMismatch", x, err)
+	}
+
+	if res := x.Mul(decimal128.MustParse("0.5")); res.String() != "6.170 USD" {
+		t.Errorf("%v.Mul(0.5) = %v, want 6.170 USD", x, res)
+	}
+
+	if res := x.Quo(decimal128.New(4, 0)); !res.Amount().Equal(decimal128.MustParse("3.085")) || res.Currency() != usd {
+		t.Errorf("%v.Quo(4) = %v, want 3.085 USD", x, res)
+	}
+
+	if res := x.Neg(); res.String() != "-12.34 USD" || res.Sign() != -1 || !res.Abs().Equal(x) {
+		t.Errorf("%v.Neg() = %v, want -12.34 USD", x, res)
+	}
+
+	if x.Equal(z) || !x.Equal(New(decimal128.MustParse("12.340"), usd)) {
+		t.Errorf("%v.Equal gave unexpected results", x)
+	}
+}
+
+func TestCurrency(t *testing.T) {
+	t.Parallel()
+
+	values := []struct {
+		code  string
+		res   string
+		minor int
+	}{
+		{"USD", "USD", 2},
+		{"eur", "EUR", 2},
+		{"JPY", "JPY", 0},
+		{"KRW", "KRW", 0},
+		{"BHD", "BHD", 3},
+		{"KWD", "KWD", 3},
+		{"CLF", "CLF", 4},
+	}
+
+	for _, val := range values {
+		c, err := ParseCurrency(val.code)
+
+		if err != nil || c.Code() != val.res || c.String() != val.res || c.MinorUnits() != val.minor {
+			t.Errorf("ParseCurrency(%q) = (%v (%d), %v), want (%s (%d), <nil>)", val.code, c, c.MinorUnits(), err, val.res, val.minor)
+		}
+	}
+
+	for _, code := range []string{"", "US", "USDX", "XXX", "ABC"} {
+		if _, err := ParseCurrency(code); !errors.Is(err, ErrUnknownCurrency) {
+			t.Errorf("ParseCurrency(%q) error = %v, want ErrUnknownCurrency", code, err)
+		}
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	values := []struct {
+		amount   string
+		currency string
+		res      string
+	}{
+		{"12.34", "USD", `{"amount":"12.34","currency":"USD"}`},
+		{"12.3", "USD", `{"amount":"12.30","currency":"USD"}`},
+		{"12.345", "USD", `{"amount":"12.345","currency":"USD"}`},
+		{"-5", "EUR", `{"amount":"-5.00","currency":"EUR"}`},
+		{"1e3", "JPY", `{"amount":"1000","currency":"JPY"}`},
+		{"1.5", "BHD", `{"amount":"1.500","currency":"BHD"}`},
+	}
+
+	for _, val := range values {
+		m, err := Parse(val.amount, val.currency)
+		if err != nil {
+			t.Fatalf("Parse(%q, %q) error = %v", val.amount, val.currency, err)
+		}
+
+		res, err := json.Marshal(m)
+		if err != nil || string(res) != val.res {
+			t.Errorf("json.Marshal(%v) = (%s, %v), want (%s, <nil>)", m, res, err, val.res)
+		}
+
+		var back Money
+		if err := json.Unmarshal(res, &back); err != nil || !back.Equal(m) {
+			t.Errorf("json.Unmarshal(%s) = (%v, %v), want (%v, <nil>)", res, back, err, m)
+		}
+	}
+
+	for _, amount := range []decimal128.Decimal{{}, decimal128.New(0, 0)} {
+		m := New(amount, MustParseCurrency("USD"))
+
+		if res := m.String(); res != "0.00 USD" {
+			t.Errorf("%v.String() = %s, want 0.00 USD", amount, res)
+		}
+
+		res, err := json.Marshal(m)
+		if err != nil || string(res) != `{"amount":"0.00","currency":"USD"}` {
+			t.Errorf("json.Marshal(%v) = (%s, %v), want ({\"amount\":\"0.00\",\"currency\":\"USD\"}, <nil>)", amount, res, err)
+		}
+
+		var back Money
+		if err := json.Unmarshal(res, &back); err != nil || !back.Equal(m) {
+			t.Errorf("json.Unmarshal(%s) = (%v, %v), want (%v, <nil>)", res, back, err, m)
+		}
+	}
+
+	if _, err := json.Marshal(Money{}); err == nil {
+		t.Errorf("json.Marshal(Money{}) succeeded, want error")
+	}
+
+	if _, err := json.Marshal(New(decimal128.NaN(), MustParseCurrency("USD"))); err == nil {
+		t.Errorf("json.Marshal(NaN USD) succeeded, want error")
+	}
+}
+
+func TestRound(t *testing.T) {
+	t.Parallel()
+
+	values := []struct {
+		amount   string
+		currency string
+		mode     decimal128.RoundingMode
+		res      string
+	}{
+		{"12.345", "USD", decimal128.ToNearestEven, "12.34 USD"},
+		{"12.345", "USD", decimal128.ToNearestAway, "12.35 USD"},
+		{"12.3", "USD", decimal128.ToNearestEven, "12.30 USD"},
+		{"-0.005", "EUR", decimal128.ToNegativeInf, "-0.01 EUR"},
+		{"1234.5", "JPY", decimal128.ToNearestEven, "1234 JPY"},
+		{"1.23456", "KWD", decimal128.ToZero, "1.234 KWD"},
+	}
+
+	for _, val := range values {
+		m, err := Parse(val.amount, val.currency)
+		if err != nil {
+			t.Fatalf("Parse(%q, %q) error = %v", val.amount, val.currency, err)
+		}
+
+		if res := m.Round(val.mode); res.String() != val.res {
+			t.Errorf("%v.Round(%v) = %v, want %s", m, val.mode, res, val.res)
+		}
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	values := []struct {
+		data string
+		res  string
+		err  error
+	}{
+		{`{"amount":"12.34","currency":"USD"}`, "12.34 USD", nil},
+		{`{"currency":"usd","amount":12.5}`, "12.50 USD", nil},
+		{`{"amount":"12.34","currency":"ABC"}`, "", ErrUnknownCurrency},
+		{`{"amount":"NaN","currency":"USD"}`, "", nil},
+		{`{"amount":"1x","currency":"USD"}`, "", nil},
+		{`{"amount":true,"currency":"USD"}`, "", nil},
+		{`[1]`, "", nil},
+	}
+
+	for _, val := range values {
+		var m Money
+		err := json.Unmarshal([]byte(val.data), &m)
+
+		if val.res == "" {
+			if err == nil || (val.err != nil && !errors.Is(err, val.err)) {
+				t.Errorf("json.Unmarshal(%s) = (%v, %v), want error", val.data, m, err)
+			}
+
+			continue
+		}
+
+		if err != nil || m.String() != val.res {
+			t.Errorf("json.Unmarshal(%s) = (%v, %v), want (%s, <nil>)", val.data, m, err, val.res)
+		}
+	}
+}
+
+func TestUnmarshalText(t *testing.T) {
+	t.Parallel()
+
+	usd := New(decimal128.MustParse("12.34"), MustParseCurrency("USD"))
+
+	text, err := usd.MarshalText()
+	if err != nil || string(text) != "12.34 USD" {
+		t.Errorf("%v.MarshalText() = (%s, %v), want (12.34 USD, <nil>)", usd, text, err)
+	}
+
+	var m Money
+	if err := m.UnmarshalText(text); err != nil || !m.Equal(usd) {
+		t.Errorf("UnmarshalText(%s) = (%v, %v), want (%v, <nil>)", text, m, err, usd)
+	}
+
+	for _, data := range []string{"12.34", "12.34 ABC", "abc USD", "Inf USD"} {
+		if err := m.UnmarshalText([]byte(data)); err == nil {
+			t.Errorf("UnmarshalText(%q) succeeded, want error", data)
+		}
+	}
+}
@@ -0,0 +1,829 @@
+package decimal128
+
+import (
+	"bufio"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// The oracle evaluates functions using math/big, starting at oracleMinPrec
+// bits and doubling the precision whenever the approximation is too close to
+// a rounding boundary to decide the correctly rounded result. A result that
+// stays on a boundary must be exact, which is checked using rational
+// arithmetic. The last oracleGuard bits of each approximation are assumed to
+// be wrong.
+const (
+	oracleMinPrec = 512
+	oracleMaxPrec = 1 << 16
+	oracleGuard   = 64
+)
+
+var oracleConsts sync.Map
+
+type oracleCase struct {
+	line string
+	args []Decimal
+	n    int64
+}
+
+type oracleFunc struct {
+	// approx returns an approximation of the result at the given precision,
+	// or nil if the oracle does not handle the input.
+	approx func(prec uint) *big.Float
+	// exact reports whether c is exactly the result.
+	exact func(c *big.Rat) bool
+}
+
+func TestCorrectRounding(t *testing.T) {
+	t.Parallel()
+
+	unary := []struct {
+		name   string
+		prefix string
+		fn     func(Decimal, RoundingMode) Decimal
+		ref    func(Decimal) oracleFunc
+	}{
+		{"Cbrt", "cbrt(", CbrtWithMode, oracleCbrt},
+		{"Exp", "exp(", ExpWithMode, oracleExp},
+		{"Exp10", "exp10(", Exp10WithMode, oracleExp10},
+		{"Exp2", "exp2(", Exp2WithMode, oracleExp2},
+		{"Expm1", "expm1(", Expm1WithMode, oracleExpm1},
+		{"Log", "log(", LogWithMode, oracleLog},
+		{"Log10", "log10(", Log10WithMode, oracleLog10},
+		{"Log1p", "log1p(", Log1pWithMode, oracleLog1p},
+		{"Log2", "log2(", Log2WithMode, oracleLog2},
+		{"Sqrt", "sqrt(", SqrtWithMode, oracleSqrt},
+	}
+
+	for _, fn := range unary {
+		t.Run(fn.name, func(t *testing.T) {
+			t.Parallel()
+
+			for _, c := range readOracleCases(t, "Test"+fn.name, fn.prefix) {
+				checkOracle(t, c.line, fn.ref(c.args[0]), func(mode RoundingMode) Decimal {
+					return fn.fn(c.args[0], mode)
+				})
+			}
+		})
+	}
+
+	t.Run("Pow", func(t *testing.T) {
+		t.Parallel()
+
+		for _, c := range readOracleCases(t, "TestDecimalPow", "") {
+			checkOracle(t, c.line, oraclePow(c.args[0], c.args[1]), func(mode RoundingMode) Decimal {
+				return c.args[0].PowWithMode(c.args[1], mode)
+			})
+		}
+	})
+
+	t.Run("PowInt", func(t *testing.T) {
+		t.Parallel()
+
+		for _, c := range readOracleCases(t, "TestPowInt", "") {
+			checkOracle(t, c.line, oraclePow(c.args[0], FromInt64(c.n)), func(mode RoundingMode) Decimal {
+				return PowIntWithMode(c.args[0], c.n, mode)
+			})
+		}
+	})
+
+	t.Run("Root", func(t *testing.T) {
+		t.Parallel()
+
+		for _, c := range readOracleCases(t, "TestRoot", "root(") {
+			checkOracle(t, c.line, oracleRoot(c.args[0], c.n), func(mode RoundingMode) Decimal {
+				return RootWithMode(c.args[0], int(c.n), mode)
+			})
+		}
+	})
+}
+
+func checkOracle(t *testing.T, line string, ref oracleFunc, fn func(RoundingMode) Decimal) {
+	t.Helper()
+
+	// Approximations are shared between rounding modes.
+	approx := make(map[uint]*big.Float)
+
+	for _, mode := range roundingModes {
+		var want Decimal
+
+		for prec := uint(oracleMinPrec); ; prec *= 2 {
+			v, ok := approx[prec]
+			if !ok {
+				v = ref.approx(prec)
+				approx[prec] = v
+			}
+
+			if v == nil {
+				return
+			}
+
+			if want, ok = oracleRound(v, prec, ref.exact, mode); ok {
+				break
+			}
+
+			if prec >= oracleMaxPrec {
+				t.Errorf("%s: unable to determine %v result", line, mode)
+				return
+			}
+		}
+
+		if got := fn(mode); !resultEqual(got, want) {
+			t.Errorf("%s: %v result = %v, want %v", line, mode, got, want)
+		}
+	}
+}
+
+// readOracleCases returns the finite inputs from the testdata files of the
+// named test. Lines are either of the form "prefix(x) = ...",
+// "prefix(x, n) = ..." or "x ^ y = ...".
+func readOracleCases(t *testing.T, test, prefix string) []oracleCase {
+	t.Helper()
+
+	files, err := filepath.Glob(filepath.Join("testdata", test, "*.txt"))
+	if err != nil || len(files) == 0 {
+		t.Fatalf("error reading testdata for %s: %v", test, err)
+	}
+
+	var cases []oracleCase
+	for _, file := range files {
+		f, err := os.Open(file)
+		if err != nil {
+			t.Fatalf("error opening file %s: %v", file, err)
+		}
+
+		s := bufio.NewScanner(f)
+		for s.Scan() {
+			line := s.Text()
+
+			lhs, _, ok := strings.Cut(line, " = ")
+			if !ok {
+				continue
+			}
+
+			var args []string
+			if prefix != "" {
+				lhs, ok = strings.CutPrefix(lhs, prefix)
+				if !ok {
+					continue
+				}
+
+				args = strings.Split(strings.TrimSuffix(lhs, ")"), ", ")
+			} else {
+				args = strings.Split(lhs, " ^ ")
+			}
+
+			c := oracleCase{line: line}
+
+			if test == "TestPowInt" || test == "TestRoot" {
+				c.n, err = strconv.ParseInt(args[1], 10, 64)
+				if err != nil {
+					t.Fatalf("%s: invalid integer argument: %v", line, err)
+				}
+
+				args = args[:1]
+			}
+
+			finite := true
+			for _, arg := range args {
+				d, err := Parse(arg)
+				if err != nil {
+					t.Fatalf("%s: invalid argument: %v", line, err)
+				}
+
+				finite = finite && !d.isSpecial()
+				c.args = append(c.args, d)
+			}
+
+			if finite {
+				cases = append(cases, c)
+			}
+		}
+
+		f.Close()
+
+		if err := s.Err(); err != nil {
+			t.Fatalf("error reading file %s: %v", file, err)
+		}
+	}
+
+	return cases
+}
+
+// oracleRound rounds v, which has a relative error of at most
+// 2**(oracleGuard-prec), following the conventions of this package: results
+// that overflow are infinite in every rounding mode, and results below a tenth
+// of the smallest subnormal are zero in every rounding mode. It returns false
+// if v is too close to a rounding boundary to decide the result.
+func oracleRound(v *big.Float, prec uint, exact func(*big.Rat) bool, mode RoundingMode) (Decimal, bool) {
+	neg := v.Signbit()
+
+	if v.IsInf() {
+		return inf(neg), true
+	}
+
+	if v.Sign() == 0 {
+		return zero(neg), true
+	}
+
+	abs := new(big.Float).SetPrec(prec).Abs(v)
+
+	// Coefficients are reduced until they are no larger than this limit,
+	// which matches the rounding code in this package.
+	lim := new(big.Int).SetBits(oracleWords(uint128{0xffff_ffff_ffff_ffff, 0x0002_7fff_ffff_ffff}))
+	limFloat := new(big.Float).SetInt(new(big.Int).Add(lim, big.NewInt(1)))
+
+	// Find the smallest exponent at which the integer part of the result is
+	// no larger than the limit.
+	exp := max(int(float64(abs.MantExp(nil))*0.30102999566398120)-40, minUnbiasedExponent)
+	for oracleScale(abs, exp).Cmp(limFloat) >= 0 {
+		exp++
+	}
+
+	tol := new(big.Float).SetMantExp(big.NewFloat(1), 114+oracleGuard-int(prec))
+	half := big.NewFloat(0.5)
+
+	scl := oracleScale(abs, exp)
+
+	if exp == minUnbiasedExponent {
+		// Results below a tenth of the smallest subnormal are zero.
+		dist := new(big.Float).SetPrec(prec).Mul(scl, big.NewFloat(10))
+		dist.Sub(dist, big.NewFloat(1))
+
+		if new(big.Float).Abs(dist).Cmp(tol) < 0 {
+			if !exact(oracleScaleRat(big.NewRat(1, 1), neg, exp-1)) {
+				return Decimal{}, false
+			}
+		} else if dist.Sign() < 0 {
+			return zero(neg), true
+		}
+	}
+
+	sig, _ := scl.Int(nil)
+	frc := new(big.Float).SetPrec(prec).Sub(scl, new(big.Float).SetInt(sig))
+	dist := new(big.Float).SetPrec(prec).Sub(frc, half)
+
+	// cmp compares the discarded fraction against a half, with 2 meaning
+	// that nothing is discarded.
+	var cmp int
+	switch {
+	case frc.Cmp(tol) < 0 || new(big.Float).Sub(big.NewFloat(1), frc).Cmp(tol) < 0:
+		if frc.Cmp(half) > 0 {
+			sig.Add(sig, big.NewInt(1))
+		}
+
+		c := new(big.Rat).SetInt(sig)
+		if !exact(oracleScaleRat(c, neg, exp)) {
+			return Decimal{}, false
+		}
+
+		cmp = 2
+	case dist.Abs(dist).Cmp(tol) < 0:
+		c := new(big.Rat).SetFrac(new(big.Int).Add(new(big.Int).Lsh(sig, 1), big.NewInt(1)), big.NewInt(2))
+		if !exact(oracleScaleRat(c, neg, exp)) {
+			return Decimal{}, false
+		}
+
+		cmp = 0
+	case frc.Cmp(half) < 0:
+		cmp = -1
+	default:
+		cmp = 1
+	}
+
+	var up bool
+	if cmp != 2 {
+		switch mode {
+		case ToNearestEven:
+			up = cmp > 0 || cmp == 0 && sig.Bit(0) == 1
+		case ToNearestAway:
+			up = cmp >= 0
+		case ToZero:
+			up = false
+		case AwayFromZero:
+			up = true
+		case ToNegativeInf:
+			up = neg
+		case ToPositiveInf:
+			up = !neg
+		case ToNearestTowardZero:
+			up = cmp > 0
+		case ToZero05Up:
+			digit := new(big.Int).Rem(sig, big.NewInt(10)).Int64()
+			up = digit == 0 || digit == 5
+		case ToOdd:
+			up = sig.Bit(0) == 0
+		}
+	}
+
+	if up {
+		sig.Add(sig, big.NewInt(1))
+	}
+
+	if sig.Cmp(lim) > 0 {
+		// The result was rounded up to one more than the limit, which is a
+		// multiple of ten.
+		sig.Quo(sig, big.NewInt(10))
+		exp++
+	}
+
+	for exp > maxUnbiasedExponent {
+		sig.Mul(sig, big.NewInt(10))
+		exp--
+
+		if sig.Cmp(lim) > 0 {
+			return inf(neg), true
+		}
+	}
+
+	buf := sig.FillBytes(make([]byte, 16))
+	var res uint128
+	for i := range 8 {
+		res[1] = res[1]<<8 | uint64(buf[i])
+		res[0] = res[0]<<8 | uint64(buf[i+8])
+	}
+
+	return compose(neg, res, int16(exp+exponentBias)), true
+}
+
+func oracleWords(sig uint128) []big.Word {
+	if strconv.IntSize == 32 {
+		return []big.Word{
+			big.Word(sig[0]), big.Word(sig[0] >> 32),
+			big.Word(sig[1]), big.Word(sig[1] >> 32),
+		}
+	}
+
+	return []big.Word{big.Word(sig[0]), big.Word(sig[1])}
+}
+
+func oraclePow10(exp int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(max(exp, -exp))), nil)
+}
+
+// oracleScale returns x / 10**exp.
+func oracleScale(x *big.Float, exp int) *big.Float {
+	p10 := new(big.Float).SetPrec(x.Prec()).SetInt(oraclePow10(exp))
+	if exp < 0 {
+		return p10.Mul(x, p10)
+	}
+
+	return p10.Quo(x, p10)
+}
+
+// oracleScaleRat returns ±x * 10**exp.
+func oracleScaleRat(x *big.Rat, neg bool, exp int) *big.Rat {
+	p10 := new(big.Rat).SetInt(oraclePow10(exp))
+	if exp < 0 {
+		x.Quo(x, p10)
+	} else {
+		x.Mul(x, p10)
+	}
+
+	if neg {
+		x.Neg(x)
+	}
+
+	return x
+}
+
+func oracleRat(d Decimal) *big.Rat {
+	sig, exp := d.decompose()
+
+	res := new(big.Rat).SetInt(new(big.Int).SetBits(oracleWords(sig)))
+	return oracleScaleRat(res, d.Signbit(), int(exp)-exponentBias)
+}
+
+// oracleFloat converts d to a big.Float. Extra bits are kept so that x - 1 is
+// still accurate to prec bits when d is close to 1.
+func oracleFloat(d Decimal, prec uint) *big.Float {
+	return new(big.Float).SetPrec(prec + 128).SetRat(oracleRat(d))
+}
+
+// oracleRatPow returns x**n, or nil if the result would be unreasonably large.
+func oracleRatPow(x *big.Rat, n int64) *big.Rat {
+	if n == 0 {
+		return big.NewRat(1, 1)
+	}
+
+	if x.Sign() == 0 {
+		return nil
+	}
+
+	if x.IsInt() && x.Num().IsInt64() && (x.Num().Int64() == 1 || x.Num().Int64() == -1) {
+		if n&1 == 0 {
+			return big.NewRat(1, 1)
+		}
+
+		return new(big.Rat).Set(x)
+	}
+
+	abs := max(n, -n)
+	if size := int64(x.Num().BitLen() + x.Denom().BitLen()); size > 1<<24/abs {
+		return nil
+	}
+
+	num := new(big.Int).Exp(x.Num(), big.NewInt(abs), nil)
+	den := new(big.Int).Exp(x.Denom(), big.NewInt(abs), nil)
+
+	if n < 0 {
+		num, den = den, num
+	}
+
+	return new(big.Rat).SetFrac(num, den)
+}
+
+// oracleConst returns a constant calculated at the given precision, caching
+// the result.
+func oracleConst(name string, prec uint, calc func(prec uint) *big.Float) *big.Float {
+	type key struct {
+		name string
+		prec uint
+	}
+
+	if v, ok := oracleConsts.Load(key{name, prec}); ok {
+		return v.(*big.Float)
+	}
+
+	v := calc(prec)
+	oracleConsts.Store(key{name, prec}, v)
+	return v
+}
+
+func oracleLn2(prec uint) *big.Float {
+	return oracleConst("ln2", prec, func(prec uint) *big.Float {
+		x := new(big.Float).SetPrec(prec).Quo(big.NewFloat(1), big.NewFloat(3))
+		return oracleAtanh(x, 2)
+	})
+}
+
+func oracleLn10(prec uint) *big.Float {
+	return oracleConst("ln10", prec, func(prec uint) *big.Float {
+		// ln(10) = 3 ln(2) + ln(5/4) = 3 ln(2) + 2 atanh(1/9)
+		x := new(big.Float).SetPrec(prec).Quo(big.NewFloat(1), big.NewFloat(9))
+		res := oracleAtanh(x, 2)
+		ln8 := new(big.Float).SetPrec(prec).Mul(oracleLn2(prec), big.NewFloat(3))
+		return res.Add(res, ln8)
+	})
+}
+
+// oracleAtanh returns mul * atanh(x) for small |x|.
+func oracleAtanh(x *big.Float, mul int64) *big.Float {
+	prec := x.Prec()
+
+	x2 := new(big.Float).SetPrec(prec).Mul(x, x)
+	pow := new(big.Float).SetPrec(prec).Set(x)
+	res := new(big.Float).SetPrec(prec)
+
+	for i := int64(1); ; i += 2 {
+		term := new(big.Float).SetPrec(prec).Quo(pow, big.NewFloat(float64(i)))
+		if term.Sign() == 0 || res.Sign() != 0 && term.MantExp(nil) < res.MantExp(nil)-int(prec)-8 {
+			break
+		}
+
+		res.Add(res, term)
+		pow.Mul(pow, x2)
+	}
+
+	return res.Mul(res, big.NewFloat(float64(mul)))
+}
+
+// oracleLn returns ln(x) for x > 0.
+func oracleLn(x *big.Float) *big.Float {
+	prec := x.Prec()
+
+	dist := new(big.Float).SetPrec(prec).Sub(x, big.NewFloat(1))
+	if dist.Sign() == 0 || dist.MantExp(nil) < -8 {
+		// Use the series directly when x is close to 1, so that the
+		// result keeps its relative precision.
+		den := new(big.Float).SetPrec(prec).Add(x, big.NewFloat(1))
+		return oracleAtanh(dist.Quo(dist, den), 2)
+	}
+
+	mant := new(big.Float).SetPrec(prec)
+	exp := x.MantExp(mant)
+
+	// Take square roots of the mantissa so that the series for
+	// ln(m) = 2 atanh((m - 1) / (m + 1)) converges quickly.
+	const roots = 12
+	for range roots {
+		mant.Sqrt(mant)
+	}
+
+	num := new(big.Float).SetPrec(prec).Sub(mant, big.NewFloat(1))
+	den := new(big.Float).SetPrec(prec).Add(mant, big.NewFloat(1))
+	res := oracleAtanh(num.Quo(num, den), 2<<roots)
+
+	ln2 := new(big.Float).SetPrec(prec).Mul(oracleLn2(prec), big.NewFloat(float64(exp)))
+	return res.Add(res, ln2)
+}
+
+// oracleExp returns e**x. Results that certainly overflow are returned as
+// infinity and results that certainly underflow as zero.
+func oracleExpFloat(x *big.Float) *big.Float {
+	prec := x.Prec()
+
+	if x.Sign() == 0 {
+		return new(big.Float).SetPrec(prec).SetInt64(1)
+	}
+
+	if x.MantExp(nil) > 15 {
+		if x.Sign() > 0 {
+			return new(big.Float).SetPrec(prec).SetInf(false)
+		}
+
+		return new(big.Float).SetPrec(prec)
+	}
+
+	k, _ := new(big.Float).SetPrec(prec).Quo(x, oracleLn2(prec)).Int64()
+	r := new(big.Float).SetPrec(prec).Mul(oracleLn2(prec), big.NewFloat(float64(k)))
+	r.Sub(x, r)
+
+	const squares = 16
+	r.SetMantExp(r, -squares)
+
+	res := new(big.Float).SetPrec(prec).SetInt64(1)
+	term := new(big.Float).SetPrec(prec).SetInt64(1)
+	for i := int64(1); ; i++ {
+		term.Mul(term, r)
+		term.Quo(term, big.NewFloat(float64(i)))
+		if term.Sign() == 0 || term.MantExp(nil) < -int(prec)-8 {
+			break
+		}
+
+		res.Add(res, term)
+	}
+
+	for range squares {
+		res.Mul(res, res)
+	}
+
+	return res.SetMantExp(res, int(k))
+}
+
+// oracleSeries returns the sum of the series x + c(2) x**2 + c(3) x**3 + ...,
+// where c(i) = c(i - 1) * mul(i) and c(1) = 1.
+func oracleSeries(x *big.Float, mul func(i int64) *big.Float) *big.Float {
+	prec := x.Prec()
+
+	res := new(big.Float).SetPrec(prec).Set(x)
+	term := new(big.Float).SetPrec(prec).Set(x)
+	for i := int64(2); ; i++ {
+		term.Mul(term, x)
+		term.Mul(term, mul(i))
+		if term.Sign() == 0 || term.MantExp(nil) < res.MantExp(nil)-int(prec)-8 {
+			break
+		}
+
+		res.Add(res, term)
+	}
+
+	return res
+}
+
+func oracleCbrt(d Decimal) oracleFunc {
+	return oracleRoot(d, 3)
+}
+
+func oracleExp(d Decimal) oracleFunc {
+	return oracleFunc{
+		approx: func(prec uint) *big.Float {
+			return oracleExpFloat(oracleFloat(d, prec))
+		},
+		exact: func(c *big.Rat) bool {
+			return d.IsZero() && c.Cmp(big.NewRat(1, 1)) == 0
+		},
+	}
+}
+
+func oracleExp10(d Decimal) oracleFunc {
+	return oracleExpBase(d, 10)
+}
+
+func oracleExp2(d Decimal) oracleFunc {
+	return oracleExpBase(d, 2)
+}
+
+// oracleExpBase returns base**d for a base of either 2 or 10.
+func oracleExpBase(d Decimal, base int64) oracleFunc {
+	return oracleFunc{
+		approx: func(prec uint) *big.Float {
+			x := oracleFloat(d, prec)
+			if base == 2 {
+				return oracleExpFloat(x.Mul(x, oracleLn2(prec)))
+			}
+
+			return oracleExpFloat(x.Mul(x, oracleLn10(prec)))
+		},
+		exact: func(c *big.Rat) bool {
+			n, ok := d.Int64()
+			if !ok || !Trunc(d).Equal(d) {
+				return false
+			}
+
+			p := oracleRatPow(big.NewRat(base, 1), n)
+			return p != nil && c.Cmp(p) == 0
+		},
+	}
+}
+
+func oracleExpm1(d Decimal) oracleFunc {
+	return oracleFunc{
+		approx: func(prec uint) *big.Float {
+			if d.IsZero() {
+				return nil
+			}
+
+			x := oracleFloat(d, prec)
+
+			if x.MantExp(nil) < -64 {
+				// e**x - 1 = x + x**2/2 + x**3/6 + ...
+				return oracleSeries(x, func(i int64) *big.Float {
+					return new(big.Float).SetPrec(prec).Quo(big.NewFloat(1), big.NewFloat(float64(i)))
+				})
+			}
+
+			// Avoid losing precision to the cancellation in e**x - 1.
+			x.SetPrec(prec + 128)
+			res := oracleExpFloat(x)
+
+			if res.Sign() == 0 || res.MantExp(nil) < -int(prec) {
+				// The result is a tiny distance above -1. Any value in the
+				// same interval between neighbouring decimals rounds the same
+				// way, so use one that is far enough from -1 to be decided.
+				res.SetInt64(1)
+				res.SetMantExp(res, -int(prec)/2)
+			}
+
+			if !res.IsInf() {
+				res.Sub(res, big.NewFloat(1))
+			}
+
+			return res.SetPrec(prec)
+		},
+		exact: func(*big.Rat) bool {
+			return false
+		},
+	}
+}
+
+func oracleLog(d Decimal) oracleFunc {
+	return oracleLogBase(d, 0)
+}
+
+func oracleLog10(d Decimal) oracleFunc {
+	return oracleLogBase(d, 10)
+}
+
+func oracleLog2(d Decimal) oracleFunc {
+	return oracleLogBase(d, 2)
+}
+
+// oracleLogBase returns the logarithm of d in the given base, which is either
+// 2 or 10, or the natural logarithm if base is 0.
+func oracleLogBase(d Decimal, base int64) oracleFunc {
+	return oracleFunc{
+		approx: func(prec uint) *big.Float {
+			if d.Signbit() || d.IsZero() {
+				return nil
+			}
+
+			if d.Equal(one(false)) {
+				return new(big.Float)
+			}
+
+			res := oracleLn(oracleFloat(d, prec))
+
+			switch base {
+			case 2:
+				return res.Quo(res, oracleLn2(prec))
+			case 10:
+				return res.Quo(res, oracleLn10(prec))
+			default:
+				return res
+			}
+		},
+		exact: func(c *big.Rat) bool {
+			if base == 0 || !c.IsInt() || !c.Num().IsInt64() {
+				return false
+			}
+
+			p := oracleRatPow(big.NewRat(base, 1), c.Num().Int64())
+			return p != nil && p.Cmp(oracleRat(d)) == 0
+		},
+	}
+}
+
+func oracleLog1p(d Decimal) oracleFunc {
+	return oracleFunc{
+		approx: func(prec uint) *big.Float {
+			x := oracleFloat(d, prec)
+
+			if x.Cmp(big.NewFloat(-1)) <= 0 || x.Sign() == 0 {
+				return nil
+			}
+
+			if x.MantExp(nil) < -64 {
+				// ln(1 + x) = x - x**2/2 + x**3/3 - ...
+				return oracleSeries(x, func(i int64) *big.Float {
+					return new(big.Float).SetPrec(prec).Quo(big.NewFloat(float64(1-i)), big.NewFloat(float64(i)))
+				})
+			}
+
+			// Calculate 1 + x exactly before taking the logarithm.
+			y := new(big.Rat).Add(oracleRat(d), big.NewRat(1, 1))
+			return oracleLn(new(big.Float).SetPrec(prec).SetRat(y))
+		},
+		exact: func(*big.Rat) bool {
+			return false
+		},
+	}
+}
+
+func oracleSqrt(d Decimal) oracleFunc {
+	return oracleRoot(d, 2)
+}
+
+func oraclePow(x, y Decimal) oracleFunc {
+	return oracleFunc{
+		approx: func(prec uint) *big.Float {
+			if x.IsZero() || y.IsZero() {
+				return nil
+			}
+
+			neg := false
+			if x.Signbit() {
+				if !Trunc(y).Equal(y) {
+					return nil
+				}
+
+				neg = y.Int(nil).Bit(0) == 1
+			}
+
+			ln := oracleLn(oracleFloat(Abs(x), prec))
+			ln.Mul(ln, oracleFloat(y, prec))
+
+			var res *big.Float
+			if ln.Sign() != 0 && ln.MantExp(nil) < -int(prec)/2 {
+				// The result is a tiny distance from 1. Any value in the same
+				// interval between neighbouring decimals rounds the same way,
+				// so use one that is far enough from 1 to be decided.
+				eps := big.NewFloat(float64(ln.Sign()))
+				eps.SetMantExp(eps, -int(prec)/2)
+				res = new(big.Float).SetPrec(prec).SetInt64(1)
+				res.Add(res, eps)
+			} else {
+				res = oracleExpFloat(ln)
+			}
+
+			if neg {
+				res.Neg(res)
+			}
+
+			return res
+		},
+		exact: func(c *big.Rat) bool {
+			if xr := oracleRat(x); xr.Num().CmpAbs(xr.Denom()) == 0 {
+				return new(big.Rat).Abs(c).Cmp(big.NewRat(1, 1)) == 0
+			}
+
+			// c = x**(p/q) exactly if c**q = x**p.
+			r := oracleRat(y)
+			if !r.Num().IsInt64() || !r.Denom().IsInt64() {
+				return false
+			}
+
+			lhs := oracleRatPow(c, r.Denom().Int64())
+			rhs := oracleRatPow(oracleRat(x), r.Num().Int64())
+			return lhs != nil && rhs != nil && lhs.Cmp(rhs) == 0
+		},
+	}
+}
+
+func oracleRoot(d Decimal, n int64) oracleFunc {
+	return oracleFunc{
+		approx: func(prec uint) *big.Float {
+			if n == 0 || d.IsZero() || d.Signbit() && n&1 == 0 {
+				return nil
+			}
+
+			ln := oracleLn(oracleFloat(Abs(d), prec))
+			res := oracleExpFloat(ln.Quo(ln, big.NewFloat(float64(n))))
+
+			if d.Signbit() {
+				res.Neg(res)
+			}
+
+			return res
+		},
+		exact: func(c *big.Rat) bool {
+			p := oracleRatPow(c, n)
+			return p != nil && p.Cmp(oracleRat(d)) == 0
+		},
+	}
+}
@@ -23,6 +23,26 @@ const (
 	payloadOpQuoRem
 	payloadOpSqrt
 	payloadOpSub
+
+	// Payloads are stored in encoded values, so new operations are appended
+	// to keep the values of existing operations stable.
+	payloadOpFMA
+	payloadOpQuantize
+	payloadOpRescale
+	payloadOpEuclidMod
+	payloadOpFloorQuo
+	payloadOpMod
+	payloadOpRemainder
+	payloadOpCos
+	payloadOpSin
+	payloadOpTan
+	payloadOpAcos
+	payloadOpAsin
+	payloadOpAcosh
+	payloadOpAtanh
+	payloadOpGamma
+	payloadOpRoot
+	payloadOpRoundToIncrement
 )
 
 const (
@@ -57,7 +77,7 @@ func (p Payload) String() string {
 		return "Payload(0)"
 	}
 
-	if p > 0x00ff_ffff {
+	if p > 0xffff_ffff || p > 0x00ff_ffff && p&0xff != payloadOpFMA {
 		return fmt.Sprintf("Payload(%d)", uint64(p))
 	}
 
@@ -78,8 +98,26 @@ func (p Payload) String() string {
 		return "Scan()"
 	case payloadOpUnmarshalText:
 		return "UnmarshalText()"
+	case payloadOpAcos:
+		return "Acos(" + p.argString(8) + ")"
+	case payloadOpAcosh:
+		return "Acosh(" + p.argString(8) + ")"
 	case payloadOpAdd:
 		return "Add(" + p.argString(8) + ", " + p.argString(16) + ")"
+	case payloadOpAsin:
+		return "Asin(" + p.argString(8) + ")"
+	case payloadOpAtanh:
+		return "Atanh(" + p.argString(8) + ")"
+	case payloadOpCos:
+		return "Cos(" + p.argString(8) + ")"
+	case payloadOpEuclidMod:
+		return "EuclidMod(" + p.argString(8) + ", " + p.argString(16) + ")"
+	case payloadOpFMA:
+		return "FMA(" + p.argString(8) + ", " + p.argString(16) + ", " + p.argString(24) + ")"
+	case payloadOpFloorQuo:
+		return "FloorQuo(" + p.argString(8) + ", " + p.argString(16) + ")"
+	case payloadOpGamma:
+		return "Gamma(" + p.argString(8) + ")"
 	case payloadOpLog:
 		return "Log(" + p.argString(8) + ")"
 	case payloadOpLog10:
@@ -88,23 +126,65 @@ func (p Payload) String() string {
 		return "Log1p(" + p.argString(8) + ")"
 	case payloadOpLog2:
 		return "Log2(" + p.argString(8) + ")"
+	case payloadOpMod:
+		return "Mod(" + p.argString(8) + ", " + p.argString(16) + ")"
 	case payloadOpMul:
 		return "Mul(" + p.argString(8) + ", " + p.argString(16) + ")"
 	case payloadOpPow:
 		return "Pow(" + p.argString(8) + ", " + p.argString(16) + ")"
+	case payloadOpQuantize:
+		return "Quantize(" + p.argString(8) + ", " + p.argString(16) + ")"
 	case payloadOpQuo:
 		return "Quo(" + p.argString(8) + ", " + p.argString(16) + ")"
 	case payloadOpQuoRem:
 		return "QuoRem(" + p.argString(8) + ", " + p.argString(16) + ")"
+	case payloadOpRemainder:
+		return "Remainder(" + p.argString(8) + ", " + p.argString(16) + ")"
+	case payloadOpRescale:
+		return "Rescale(" + p.argString(8) + ")"
+	case payloadOpRoot:
+		return "Root(" + p.argString(8) + ", " + p.argString(16) + ")"
+	case payloadOpRoundToIncrement:
+		return "RoundToIncrement(" + p.argString(8) + ", " + p.argString(16) + ")"
+	case payloadOpSin:
+		return "Sin(" + p.argString(8) + ")"
 	case payloadOpSqrt:
 		return "Sqrt(" + p.argString(8) + ")"
 	case payloadOpSub:
 		return "Sub(" + p.argString(8) + ", " + p.argString(16) + ")"
+	case payloadOpTan:
+		return "Tan(" + p.argString(8) + ")"
 	default:
 		return fmt.Sprintf("Payload(%d)", uint64(p))
 	}
 }
 
+func payloadVal(d Decimal) Payload {
+	neg := d.Signbit()
+
+	if d.isInf() {
+		if neg {
+			return payloadValNegInfinite
+		}
+
+		return payloadValPosInfinite
+	}
+
+	if d.IsZero() {
+		if neg {
+			return payloadValNegZero
+		}
+
+		return payloadValPosZero
+	}
+
+	if neg {
+		return payloadValNegFinite
+	}
+
+	return payloadValPosFinite
+}
+
 func (p Payload) argString(offset int) string {
 	switch p >> offset & 0xff {
 	case payloadValPosZero:
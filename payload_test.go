@@ -20,6 +20,26 @@ func TestDecimalPayload(t *testing.T) {
 		t.Errorf("Decimal.Compose(2, false, nil, 0).Payload() = %s, want Compose()", s)
 	}
 
+	d = Acos(FromInt64(2))
+	if s := d.Payload().String(); s != "Acos(Finite)" {
+		t.Errorf("Acos(2).Payload() = %s, want Acos(Finite)", s)
+	}
+
+	d = Acosh(New(5, -1))
+	if s := d.Payload().String(); s != "Acosh(Finite)" {
+		t.Errorf("Acosh(0.5).Payload() = %s, want Acosh(Finite)", s)
+	}
+
+	d = Asin(inf(true))
+	if s := d.Payload().String(); s != "Asin(-Infinite)" {
+		t.Errorf("Asin(-Inf).Payload() = %s, want Asin(-Infinite)", s)
+	}
+
+	d = Atanh(inf(false))
+	if s := d.Payload().String(); s != "Atanh(Infinite)" {
+		t.Errorf("Atanh(Inf).Payload() = %s, want Atanh(Infinite)", s)
+	}
+
 	d = FromFloat32(float32(math.NaN()))
 	if s := d.Payload().String(); s != "FromFloat32()" {
 		t.Errorf("FromFloat32(NaN).Payload() = %s, want FromFloat32()", s)
@@ -56,6 +76,11 @@ func TestDecimalPayload(t *testing.T) {
 		t.Errorf("Decimal.UnmarshalText(NaN).Payload() = %s, want UnmarshalText()", s)
 	}
 
+	d = Gamma(FromInt64(-2))
+	if s := d.Payload().String(); s != "Gamma(-Finite)" {
+		t.Errorf("Gamma(-2).Payload() = %s, want Gamma(-Finite)", s)
+	}
+
 	d = Log(inf(true))
 	if s := d.Payload().String(); s != "Log(-Infinite)" {
 		t.Errorf("Log(-Inf).Payload() = %s, want Log(-Infinite)", s)
@@ -111,6 +136,56 @@ func TestDecimalPayload(t *testing.T) {
 		t.Errorf("-Inf.Add(Inf).Payload() = %s, want Add(-Infinite, Infinite)", s)
 	}
 
+	d = Cos(inf(true))
+	if s := d.Payload().String(); s != "Cos(-Infinite)" {
+		t.Errorf("Cos(-Inf).Payload() = %s, want Cos(-Infinite)", s)
+	}
+
+	d = inf(true).EuclidMod(FromInt64(1))
+	if s := d.Payload().String(); s != "EuclidMod(-Infinite, Finite)" {
+		t.Errorf("-Inf.EuclidMod(1).Payload() = %s, want EuclidMod(-Infinite, Finite)", s)
+	}
+
+	d = FromInt64(1).EuclidMod(zero(false))
+	if s := d.Payload().String(); s != "EuclidMod(Finite, Zero)" {
+		t.Errorf("1.EuclidMod(0).Payload() = %s, want EuclidMod(Finite, Zero)", s)
+	}
+
+	d = FromInt64(-5).EuclidMod(inf(false))
+	if s := d.Payload().String(); s != "EuclidMod(-Finite, Infinite)" {
+		t.Errorf("-5.EuclidMod(Inf).Payload() = %s, want EuclidMod(-Finite, Infinite)", s)
+	}
+
+	d = inf(false).FloorQuo(inf(true))
+	if s := d.Payload().String(); s != "FloorQuo(Infinite, -Infinite)" {
+		t.Errorf("Inf.FloorQuo(-Inf).Payload() = %s, want FloorQuo(Infinite, -Infinite)", s)
+	}
+
+	d = zero(true).FloorQuo(zero(false))
+	if s := d.Payload().String(); s != "FloorQuo(-Zero, Zero)" {
+		t.Errorf("-0.FloorQuo(0).Payload() = %s, want FloorQuo(-Zero, Zero)", s)
+	}
+
+	d = FMA(zero(false), inf(true), FromInt64(1))
+	if s := d.Payload().String(); s != "FMA(Zero, -Infinite, Finite)" {
+		t.Errorf("FMA(0, -Inf, 1).Payload() = %s, want FMA(Zero, -Infinite, Finite)", s)
+	}
+
+	d = FMA(inf(false), FromInt64(2), inf(true))
+	if s := d.Payload().String(); s != "FMA(Infinite, Finite, -Infinite)" {
+		t.Errorf("FMA(Inf, 2, -Inf).Payload() = %s, want FMA(Infinite, Finite, -Infinite)", s)
+	}
+
+	d = inf(false).Mod(FromInt64(2))
+	if s := d.Payload().String(); s != "Mod(Infinite, Finite)" {
+		t.Errorf("Inf.Mod(2).Payload() = %s, want Mod(Infinite, Finite)", s)
+	}
+
+	d = FromInt64(-1).Mod(zero(true))
+	if s := d.Payload().String(); s != "Mod(-Finite, -Zero)" {
+		t.Errorf("-1.Mod(-0).Payload() = %s, want Mod(-Finite, -Zero)", s)
+	}
+
 	d = zero(false).Mul(inf(false))
 	if s := d.Payload().String(); s != "Mul(Zero, Infinite)" {
 		t.Errorf("0.Mul(Inf).Payload() = %s, want Mul(Zero, Infinite)", s)
@@ -141,6 +216,46 @@ func TestDecimalPayload(t *testing.T) {
 		t.Errorf("-1.Pow(-0.1).Payload() = %s, want Pow(-Finite, -Finite)", s)
 	}
 
+	d = inf(false).Quantize(FromInt64(1), ToNearestEven)
+	if s := d.Payload().String(); s != "Quantize(Infinite, Finite)" {
+		t.Errorf("Inf.Quantize(1).Payload() = %s, want Quantize(Infinite, Finite)", s)
+	}
+
+	d = FromInt64(-1).Quantize(New(1, -34), ToNearestEven)
+	if s := d.Payload().String(); s != "Quantize(-Finite, Finite)" {
+		t.Errorf("-1.Quantize(1e-34).Payload() = %s, want Quantize(-Finite, Finite)", s)
+	}
+
+	d = FromInt64(1).Rescale(34, ToNearestEven)
+	if s := d.Payload().String(); s != "Rescale(Finite)" {
+		t.Errorf("1.Rescale(34).Payload() = %s, want Rescale(Finite)", s)
+	}
+
+	d = Root(FromInt64(-4), 2)
+	if s := d.Payload().String(); s != "Root(-Finite, Finite)" {
+		t.Errorf("Root(-4, 2).Payload() = %s, want Root(-Finite, Finite)", s)
+	}
+
+	d = Root(FromInt64(4), 0)
+	if s := d.Payload().String(); s != "Root(Finite, Zero)" {
+		t.Errorf("Root(4, 0).Payload() = %s, want Root(Finite, Zero)", s)
+	}
+
+	d = FromInt64(-1).RoundToIncrement(zero(false), ToNearestEven)
+	if s := d.Payload().String(); s != "RoundToIncrement(-Finite, Zero)" {
+		t.Errorf("-1.RoundToIncrement(0).Payload() = %s, want RoundToIncrement(-Finite, Zero)", s)
+	}
+
+	d = FromInt64(1).RoundToIncrement(inf(true), ToNearestEven)
+	if s := d.Payload().String(); s != "RoundToIncrement(Finite, -Infinite)" {
+		t.Errorf("1.RoundToIncrement(-Inf).Payload() = %s, want RoundToIncrement(Finite, -Infinite)", s)
+	}
+
+	d = Sin(inf(false))
+	if s := d.Payload().String(); s != "Sin(Infinite)" {
+		t.Errorf("Sin(Inf).Payload() = %s, want Sin(Infinite)", s)
+	}
+
 	d = inf(false).Quo(inf(true))
 	if s := d.Payload().String(); s != "Quo(Infinite, -Infinite)" {
 		t.Errorf("Inf.Quo(-Inf).Payload() = %s, want Quo(Infinite, -Infinite)", s)
@@ -186,6 +301,16 @@ func TestDecimalPayload(t *testing.T) {
 		t.Errorf("-0.QuoRem(0).Payload() = %s, want QuoRem(-Zero, Zero)", s)
 	}
 
+	d = inf(true).Remainder(inf(false))
+	if s := d.Payload().String(); s != "Remainder(-Infinite, Infinite)" {
+		t.Errorf("-Inf.Remainder(Inf).Payload() = %s, want Remainder(-Infinite, Infinite)", s)
+	}
+
+	d = zero(false).Remainder(zero(false))
+	if s := d.Payload().String(); s != "Remainder(Zero, Zero)" {
+		t.Errorf("0.Remainder(0).Payload() = %s, want Remainder(Zero, Zero)", s)
+	}
+
 	_, d = FromInt64(1).QuoRem(zero(false))
 	if s := d.Payload().String(); s != "QuoRem(Finite, Zero)" {
 		t.Errorf("-0.QuoRem(0).Payload() = %s, want QuoRem(Finite, Zero)", s)
@@ -205,4 +330,34 @@ func TestDecimalPayload(t *testing.T) {
 	if s := d.Payload().String(); s != "Sub(-Infinite, -Infinite)" {
 		t.Errorf("-Inf.Sub(-Inf).Payload() = %s, want Sub(-Infinite, -Infinite)", s)
 	}
+
+	d = Tan(inf(true))
+	if s := d.Payload().String(); s != "Tan(-Infinite)" {
+		t.Errorf("Tan(-Inf).Payload() = %s, want Tan(-Infinite)", s)
+	}
+}
+
+func TestPayloadStable(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		op   Payload
+		want Payload
+	}{
+		{payloadOpCompose, 1},
+		{payloadOpUnmarshalText, 8},
+		{payloadOpAdd, 9},
+		{payloadOpLog, 10},
+		{payloadOpMul, 14},
+		{payloadOpPow, 15},
+		{payloadOpQuo, 16},
+		{payloadOpSqrt, 18},
+		{payloadOpSub, 19},
+	}
+
+	for _, tt := range tests {
+		if tt.op != tt.want {
+			t.Errorf("payload op %s = %d, want %d", tt.op, uint64(tt.op), uint64(tt.want))
+		}
+	}
 }
@@ -1,6 +1,10 @@
 package decimal128
 
-import "fmt"
+import (
+	"fmt"
+	"math/big"
+	"math/bits"
+)
 
 // Ceil returns the least integer value greater than or equal to d.
 //
@@ -46,9 +50,14 @@ func Trunc(d Decimal) Decimal {
 // verb in Format). It can be zero to return an integer, and can also be
 // negative to round off digits before the decimal point.
 //
-// NaN and infinity values are left untouched.
+// NaN and infinity values are left untouched, except that signaling NaNs are
+// converted to quiet NaNs.
 func (d Decimal) Ceil(dp int) Decimal {
 	if d.isSpecial() {
+		if d.IsNaN() {
+			return d.quiet()
+		}
+
 		return d
 	}
 
@@ -127,9 +136,14 @@ func (d Decimal) Ceil(dp int) Decimal {
 // verb in Format). It can be zero to return an integer, and can also be
 // negative to round off digits before the decimal point.
 //
-// NaN and infinity values are left untouched.
+// NaN and infinity values are left untouched, except that signaling NaNs are
+// converted to quiet NaNs.
 func (d Decimal) Floor(dp int) Decimal {
 	if d.isSpecial() {
+		if d.IsNaN() {
+			return d.quiet()
+		}
+
 		return d
 	}
 
@@ -200,6 +214,72 @@ func (d Decimal) Floor(dp int) Decimal {
 	return compose(neg, sig, exp)
 }
 
+// Quantize returns d rounded using the rounding mode provided so that it has
+// the same exponent as o, following the IEEE 754 quantize operation. Unlike
+// [Decimal.Round], the exponent of the result is always exactly that of o,
+// which means it may have trailing zeros.
+//
+// If either value is NaN, the result is NaN. If both values are infinite, the
+// result is d. If only one value is infinite, or if representing the result
+// with the exponent of o would require more than 34 significant digits, the
+// result is NaN.
+func (d Decimal) Quantize(o Decimal, mode RoundingMode) Decimal {
+	if d.isSpecial() || o.isSpecial() {
+		if d.IsNaN() {
+			return d.quiet()
+		}
+
+		if o.IsNaN() {
+			return o.quiet()
+		}
+
+		if d.isInf() && o.isInf() {
+			return d
+		}
+
+		return nan(payloadOpQuantize, payloadVal(d), payloadVal(o))
+	}
+
+	_, exp := o.decompose()
+
+	res, ok := mode.quantize(d, exp)
+	if !ok {
+		return nan(payloadOpQuantize, payloadVal(d), payloadVal(o))
+	}
+
+	return res
+}
+
+// Rescale returns d rounded using the rounding mode provided so that it has
+// exactly the specified number of decimal places. This is equivalent to
+// calling [Decimal.Quantize] with a value that has an exponent of -dp.
+//
+// The value of dp determines the exponent of the result, and so the exponent
+// reported by [Decimal.Decompose]. It can be zero to return an integer, and
+// can also be negative to round off digits before the decimal point.
+//
+// If d is NaN, the result is NaN. If d is infinite, dp is outside the range of
+// exponents a Decimal can represent, or representing the result with the
+// requested exponent would require more than 34 significant digits, the result
+// is NaN.
+func (d Decimal) Rescale(dp int, mode RoundingMode) Decimal {
+	if d.IsNaN() {
+		return d.quiet()
+	}
+
+	exp := exponentBias - dp
+	if d.isInf() || exp < 0 || exp > maxBiasedExponent {
+		return nan(payloadOpRescale, payloadVal(d), 0)
+	}
+
+	res, ok := mode.quantize(d, int16(exp))
+	if !ok {
+		return nan(payloadOpRescale, payloadVal(d), 0)
+	}
+
+	return res
+}
+
 // Round rounds (or quantises) a Decimal value to the specified number of
 // decimal places using the rounding mode provided.
 //
@@ -209,9 +289,14 @@ func (d Decimal) Floor(dp int) Decimal {
 // point and return an integer, and can also be negative to round off digits
 // before the decimal point.
 //
-// NaN and infinity values are left untouched.
+// NaN and infinity values are left untouched, except that signaling NaNs are
+// converted to quiet NaNs.
 func (d Decimal) Round(dp int, mode RoundingMode) Decimal {
 	if d.isSpecial() {
+		if d.IsNaN() {
+			return d.quiet()
+		}
+
 		return d
 	}
 
@@ -259,17 +344,124 @@ func (d Decimal) Round(dp int, mode RoundingMode) Decimal {
 	return compose(neg, sig, exp)
 }
 
+// RoundToIncrement rounds d to a multiple of inc using the rounding mode
+// provided. This can be used to round to increments that are not a power of
+// ten, such as cash amounts to the nearest 0.05 or prices to the nearest 0.25.
+// The sign of inc is ignored, and the result is always an exact multiple of
+// inc with an exponent no smaller than that of inc.
+//
+// Ties are determined by the distance to the two nearest multiples of inc, so
+// with ToNearestEven a tie is broken towards the multiple that is an even
+// number of increments from zero.
+//
+// If either value is NaN, the result is NaN. If inc is zero or infinite, or
+// the result cannot be represented exactly, the result is NaN. Otherwise, if
+// d is infinite, the result is d.
+func (d Decimal) RoundToIncrement(inc Decimal, mode RoundingMode) Decimal {
+	if d.IsNaN() {
+		return d.quiet()
+	}
+
+	if inc.IsNaN() {
+		return inc.quiet()
+	}
+
+	if inc.isInf() || inc.IsZero() {
+		return nan(payloadOpRoundToIncrement, payloadVal(d), payloadVal(inc))
+	}
+
+	if d.isInf() {
+		return d
+	}
+
+	neg := d.Signbit()
+	_, dexp := d.decompose()
+	_, iexp := inc.decompose()
+
+	// Bring both coefficients to the same exponent, so that the quotient is
+	// the number of whole increments in d.
+	num := d.Coefficient(nil)
+	num.Abs(num)
+	den := inc.Coefficient(nil)
+	den.Abs(den)
+	step := new(big.Int).Set(den)
+	ten := big.NewInt(10)
+
+	if dexp > iexp {
+		num.Mul(num, new(big.Int).Exp(ten, big.NewInt(int64(dexp-iexp)), nil))
+	} else if dexp < iexp {
+		den.Mul(den, new(big.Int).Exp(ten, big.NewInt(int64(iexp-dexp)), nil))
+	}
+
+	quo, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+
+	if rem.Sign() != 0 {
+		half := rem.Lsh(rem, 1).Cmp(den)
+		digit := new(big.Int).Rem(quo, ten).Uint64()
+
+		if mode.roundIncrement(neg, digit, half) {
+			quo.Add(quo, big.NewInt(1))
+		}
+	}
+
+	// Remove trailing zeros from the result until its coefficient fits.
+	sig := quo.Mul(quo, step)
+	exp := iexp
+	lim := new(big.Int).Exp(ten, big.NewInt(maxDigits-1), nil)
+	digit := new(big.Int)
+
+	for sig.Cmp(lim) >= 0 {
+		if exp == maxBiasedExponent {
+			return nan(payloadOpRoundToIncrement, payloadVal(d), payloadVal(inc))
+		}
+
+		sig.QuoRem(sig, ten, digit)
+		if digit.Sign() != 0 {
+			return nan(payloadOpRoundToIncrement, payloadVal(d), payloadVal(inc))
+		}
+
+		exp++
+	}
+
+	mask := new(big.Int).SetUint64(0xffff_ffff_ffff_ffff)
+	lo := new(big.Int).And(sig, mask).Uint64()
+	hi := sig.Rsh(sig, 64).Uint64()
+
+	return compose(neg, uint128{lo, hi}, exp)
+}
+
 // RoundingMode determines how a Decimal value is rounded when the result of an
 // operation is greater than the format can hold.
+//
+// ToNearestTowardZero rounds to the nearest value, breaking ties towards zero.
+// ToZero05Up rounds towards zero, unless the last digit of that result would
+// be 0 or 5, in which case it rounds away from zero. ToOdd rounds to whichever
+// of the two nearest values has an odd coefficient, unless the result is
+// exact. Results rounded with ToOdd to at least two more digits than a
+// narrower format can be rounded again to that format without double rounding
+// errors.
+//
+// Before rounding, the results of [Cbrt], [Erf], [Erfc], [Exp], [Exp10],
+// [Exp2], [Expm1], [Gamma], [Lgamma], [Log], [Log10], [Log1p], [Log2],
+// [Decimal.Pow], [PowInt], [Root], [Sqrt] and their WithMode variants have a
+// relative error below 10**-40, unless their documentation states otherwise.
+// One unit in the last place of a result is more than 10**-35 of its
+// magnitude, so a rounded result is within half a unit in the last place plus
+// 10**-5 units of the exact value when rounding to nearest, and within one
+// unit in the last place otherwise. It is correctly rounded unless the exact
+// value is within 1 part in 10**40 of a rounding boundary.
 type RoundingMode uint8
 
 const (
-	ToNearestEven RoundingMode = iota // == IEEE 754 roundTiesToEven
-	ToNearestAway                     // == IEEE 754 roundTiesToAway
-	ToZero                            // == IEEE 754 roundTowardZero
-	AwayFromZero                      // no IEEE 754 equivalent
-	ToNegativeInf                     // == IEEE 754 roundTowardNegative
-	ToPositiveInf                     // == IEEE 754 roundTowardPositive
+	ToNearestEven       RoundingMode = iota // == IEEE 754 roundTiesToEven
+	ToNearestAway                           // == IEEE 754 roundTiesToAway
+	ToZero                                  // == IEEE 754 roundTowardZero
+	AwayFromZero                            // no IEEE 754 equivalent
+	ToNegativeInf                           // == IEEE 754 roundTowardNegative
+	ToPositiveInf                           // == IEEE 754 roundTowardPositive
+	ToNearestTowardZero                     // no IEEE 754 equivalent
+	ToZero05Up                              // == General Decimal Arithmetic round-05up
+	ToOdd                                   // no IEEE 754 equivalent
 )
 
 // String returns a string representation of the rounding mode.
@@ -287,11 +479,135 @@ func (rm RoundingMode) String() string {
 		return "ToNegativeInf"
 	case ToPositiveInf:
 		return "ToPositiveInf"
+	case ToNearestTowardZero:
+		return "ToNearestTowardZero"
+	case ToZero05Up:
+		return "ToZero05Up"
+	case ToOdd:
+		return "ToOdd"
 	default:
 		return fmt.Sprintf("RoundingMode(%d)", uint8(rm))
 	}
 }
 
+func (rm RoundingMode) quantize(d Decimal, qexp int16) (Decimal, bool) {
+	neg := d.Signbit()
+	sig, exp := d.decompose()
+
+	if sig[0]|sig[1] == 0 {
+		return compose(neg, sig, qexp), true
+	}
+
+	if exp > qexp {
+		if exp-qexp >= 34 {
+			return Decimal{}, false
+		}
+
+		sig256 := sig.mul(uint128PowersOf10[exp-qexp])
+		if sig256[3]|sig256[2] != 0 {
+			return Decimal{}, false
+		}
+
+		sig = uint128{sig256[0], sig256[1]}
+	} else if exp < qexp {
+		var trunc int8
+		var digit uint64
+
+		for i := qexp - exp; i > 0; i-- {
+			if digit != 0 {
+				trunc = 1
+			}
+
+			if sig[0]|sig[1] == 0 {
+				digit = 0
+				break
+			}
+
+			sig, digit = sig.div10()
+		}
+
+		sig, exp = rm.round(false, neg, sig, qexp, trunc, digit)
+		if exp != qexp {
+			return Decimal{}, false
+		}
+	}
+
+	if sig.cmp(uint128PowersOf10[34]) >= 0 {
+		return Decimal{}, false
+	}
+
+	return compose(neg, sig, qexp), true
+}
+
+func (rm RoundingMode) reduceBig(neg bool, i *big.Int, exp int16) (uint128, int16) {
+	trunc := int8(0)
+
+	if bl := i.BitLen(); bl > 128 {
+		i = new(big.Int).Abs(i)
+		r := new(big.Int)
+
+		if bl > 256 {
+			e18 := big.NewInt(1_000_000_000_000_000_000)
+
+			for bl > 256 {
+				i.QuoRem(i, e18, r)
+				exp += 18
+
+				if exp > maxBiasedExponent {
+					return uint128{}, exp
+				}
+
+				bl = i.BitLen()
+
+				if r.Sign() != 0 {
+					trunc = 1
+				}
+			}
+		}
+
+		ten := big.NewInt(10)
+
+		for bl > 128 {
+			i.QuoRem(i, ten, r)
+			exp++
+
+			if exp > maxBiasedExponent {
+				return uint128{}, exp
+			}
+
+			bl = i.BitLen()
+
+			if r.Sign() != 0 {
+				trunc = 1
+			}
+		}
+	}
+
+	var sig uint128
+
+	b := i.Bits()
+	for i := len(b) - 1; i >= 0; i-- {
+		sig = sig.lsh(bits.UintSize)
+		sig = sig.or64(uint64(b[i]))
+	}
+
+	return rm.reduce128(neg, sig, exp, trunc)
+}
+
+func (rm RoundingMode) reduce384(neg bool, sig384 uint384, exp int16, trunc int8) (uint128, int16) {
+	for sig384[5]|sig384[4] > 0 {
+		var rem uint64
+		sig384, rem = sig384.div1e19()
+		exp += 19
+
+		if rem != 0 {
+			trunc = 1
+		}
+	}
+
+	return rm.reduce256(neg, uint256{sig384[0], sig384[1], sig384[2], sig384[3]}, exp, trunc)
+}
+
 func (rm RoundingMode) reduce256(neg bool, sig256 uint256, exp int16, trunc int8) (uint128, int16) {
 	for sig256[3] > 0 {
 		var rem uint64
@@ -652,7 +968,7 @@ func (rm RoundingMode) round(shift, neg bool, sig uint128, exp int16, trunc int8
 				}
 			}
 		case ToNearestAway:
-			if digit >= 5 {
+			if digit > 5 || digit == 5 && trunc != -1 {
 				adjust = 1
 			}
 		case ToZero:
@@ -679,6 +995,32 @@ func (rm RoundingMode) round(shift, neg bool, sig uint128, exp int16, trunc int8
 			} else if trunc == -1 && digit == 0 {
 				adjust = -1
 			}
+		case ToNearestTowardZero:
+			if digit > 5 || digit == 5 && trunc == 1 {
+				adjust = 1
+			}
+		case ToZero05Up:
+			// The last digit of the coefficient is zero if it will be shifted
+			// before adjusting it.
+			if trunc == -1 && digit == 0 {
+				if (sig[0]%5+sig[1]%5)%5 != 1 || shift && exp > minBiasedExponent && sig[1] <= 0x0002_7fff_ffff_ffff/10 {
+					adjust = -1
+				}
+			} else if trunc == 1 || digit != 0 {
+				if (sig[0]%5+sig[1]%5)%5 == 0 || shift && exp > minBiasedExponent && sig[1] < 0x0002_7fff_ffff_ffff/10 {
+					adjust = 1
+				}
+			}
+		case ToOdd:
+			if trunc == -1 && digit == 0 {
+				if sig[0]%2 == 0 || shift && exp > minBiasedExponent && sig[1] <= 0x0002_7fff_ffff_ffff/10 {
+					adjust = -1
+				}
+			} else if trunc == 1 || digit != 0 {
+				if sig[0]%2 == 0 || shift && exp > minBiasedExponent && sig[1] < 0x0002_7fff_ffff_ffff/10 {
+					adjust = 1
+				}
+			}
 		}
 
 		if adjust != 0 {
@@ -742,6 +1084,39 @@ func (rm RoundingMode) round(shift, neg bool, sig uint128, exp int16, trunc int8
 	}
 }
 
+// roundIncrement reports whether an inexact quotient whose last digit is
+// digit should be rounded away from zero. The value of half is the result of
+// comparing the remainder against half of the divisor.
+func (rm RoundingMode) roundIncrement(neg bool, digit uint64, half int) bool {
+	switch rm {
+	case ToNearestEven:
+		return half > 0 || half == 0 && digit%2 != 0
+	case ToNearestAway:
+		return half >= 0
+	case ToZero:
+		return false
+	case AwayFromZero:
+		return true
+	case ToNegativeInf:
+		return neg
+	case ToPositiveInf:
+		return !neg
+	case ToNearestTowardZero:
+		return half > 0
+	case ToZero05Up:
+		return digit == 0 || digit == 5
+	case ToOdd:
+		return digit%2 == 0
+	default:
+		return false
+	}
+}
+
 // DefaultRoundingMode is the rounding mode used by any methods where an
 // alternate rounding mode isn't provided.
+//
+// DefaultRoundingMode is read without synchronisation, so it must not be
+// modified while other goroutines may be using this package. Code that needs
+// a different rounding mode should instead use the WithMode variants of each
+// function, or perform its operations through a [Context].
 var DefaultRoundingMode RoundingMode = ToNearestEven
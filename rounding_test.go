@@ -1,6 +1,10 @@
 package decimal128
 
-import "testing"
+import (
+	"math/big"
+	"math/rand/v2"
+	"testing"
+)
 
 var roundingModes = []RoundingMode{
 	ToNearestEven,
@@ -9,6 +13,9 @@ var roundingModes = []RoundingMode{
 	AwayFromZero,
 	ToNegativeInf,
 	ToPositiveInf,
+	ToNearestTowardZero,
+	ToZero05Up,
+	ToOdd,
 }
 
 func TestDecimalCeil(t *testing.T) {
@@ -49,6 +56,55 @@ func TestDecimalFloor(t *testing.T) {
 	}
 }
 
+func TestDecimalQuantize(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var qnt Decimal
+	var res testDataResult
+
+	for r.scan("quantize(%v, %v) = %v\n", &val, &qnt, &res) {
+		for _, mode := range roundingModes {
+			q := val.Quantize(qnt, mode)
+
+			if !res.equal(q, mode) || !q.IsNaN() && !q.SameQuantum(qnt) {
+				t.Errorf("%v.Quantize(%v, %v) = %v, want %v", val, qnt, mode, q, res.result(mode))
+			}
+		}
+	}
+}
+
+func TestDecimalRescale(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var dp int
+	var res testDataResult
+
+	for r.scan("rescale(%v, %v) = %v\n", &val, &dp, &res) {
+		for _, mode := range roundingModes {
+			rsc := val.Rescale(dp, mode)
+
+			if !res.equal(rsc, mode) {
+				t.Errorf("%v.Rescale(%d, %v) = %v, want %v", val, dp, mode, rsc, res.result(mode))
+				continue
+			}
+
+			if !rsc.IsNaN() {
+				if _, exp := rsc.decompose(); int(exp)-exponentBias != -dp {
+					t.Errorf("%v.Rescale(%d, %v) has exponent %d, want %d", val, dp, mode, int(exp)-exponentBias, -dp)
+				}
+			}
+		}
+	}
+}
+
 func TestDecimalRound(t *testing.T) {
 	t.Parallel()
 
@@ -70,6 +126,131 @@ func TestDecimalRound(t *testing.T) {
 	}
 }
 
+func TestDecimalRoundToIncrement(t *testing.T) {
+	t.Parallel()
+
+	values := []struct {
+		val  string
+		inc  string
+		mode RoundingMode
+		res  string
+	}{
+		{"1.02", "0.05", ToNearestEven, "1.00"},
+		{"1.03", "0.05", ToNearestEven, "1.05"},
+		{"1.025", "0.05", ToNearestEven, "1.00"},
+		{"1.075", "0.05", ToNearestEven, "1.10"},
+		{"1.025", "0.05", ToNearestAway, "1.05"},
+		{"-1.025", "0.05", ToNearestAway, "-1.05"},
+		{"1.049", "0.05", ToZero, "1.00"},
+		{"-1.049", "0.05", ToZero, "-1.00"},
+		{"1.001", "0.05", AwayFromZero, "1.05"},
+		{"-1.001", "0.05", AwayFromZero, "-1.05"},
+		{"-1.001", "0.05", ToNegativeInf, "-1.05"},
+		{"1.049", "0.05", ToNegativeInf, "1.00"},
+		{"1.001", "0.05", ToPositiveInf, "1.05"},
+		{"-1.049", "0.05", ToPositiveInf, "-1.00"},
+		{"1.05", "0.05", AwayFromZero, "1.05"},
+		{"4312.37", "0.25", ToNearestEven, "4312.25"},
+		{"4312.375", "0.25", ToNearestEven, "4312.50"},
+		{"4312.375", "-0.25", ToNearestEven, "4312.50"},
+		{"99.9375", "0.125", ToNearestEven, "100.000"},
+		{"99.8125", "0.125", ToNearestEven, "99.750"},
+		{"99.8125", "0.125", ToNearestAway, "99.875"},
+		{"1234567", "1e3", ToNearestEven, "1.235e6"},
+		{"1500", "1e3", ToNearestEven, "2e3"},
+		{"2500", "1e3", ToNearestEven, "2e3"},
+		{"0.3", "7", ToNearestEven, "0"},
+		{"-0.3", "7", ToNearestEven, "-0"},
+		{"5", "3", ToNearestEven, "6"},
+		{"4.5", "3", ToNearestEven, "6"},
+		{"1e6000", "0.05", ToNearestEven, "1e6000"},
+		{"1e6000", "0.03", ToNearestEven, "NaN"},
+		{"1e-6000", "1e6000", AwayFromZero, "1e6000"},
+		{"9999999999999999999999999999999999", "0.1", ToNearestEven, "9999999999999999999999999999999999"},
+		{"Inf", "0.05", ToNearestEven, "Inf"},
+		{"-Inf", "0.05", ToNearestEven, "-Inf"},
+		{"1", "0", ToNearestEven, "NaN"},
+		{"1", "Inf", ToNearestEven, "NaN"},
+		{"Inf", "Inf", ToNearestEven, "NaN"},
+		{"NaN", "0.05", ToNearestEven, "NaN"},
+		{"1", "NaN", ToNearestEven, "NaN"},
+	}
+
+	for _, val := range values {
+		d := MustParse(val.val)
+		inc := MustParse(val.inc)
+		res := MustParse(val.res)
+
+		rnd := d.RoundToIncrement(inc, val.mode)
+
+		if !resultEqual(rnd, res) || rnd.Signbit() != res.Signbit() {
+			t.Errorf("%v.RoundToIncrement(%v, %v) = %v, want %v", d, inc, val.mode, rnd, res)
+		}
+	}
+}
+
+func TestDecimalRoundToIncrementRandom(t *testing.T) {
+	t.Parallel()
+
+	rnd := rand.New(rand.NewPCG(1, 2))
+
+	for range 10000 {
+		d := New(rnd.Int64N(2_000_000_000)-1_000_000_000, -rnd.IntN(12))
+		inc := New(rnd.Int64N(999)+1, -rnd.IntN(6))
+
+		quo := new(big.Rat).Quo(oracleRat(d), oracleRat(inc))
+		num := new(big.Int).Abs(quo.Num())
+		whole, rem := new(big.Int).QuoRem(num, quo.Denom(), new(big.Int))
+		cmp := new(big.Int).Lsh(rem, 1).Cmp(quo.Denom())
+		neg := d.Signbit()
+
+		for _, mode := range roundingModes {
+			var up bool
+			if rem.Sign() != 0 {
+				switch mode {
+				case ToNearestEven:
+					up = cmp > 0 || cmp == 0 && whole.Bit(0) == 1
+				case ToNearestAway:
+					up = cmp >= 0
+				case AwayFromZero:
+					up = true
+				case ToNegativeInf:
+					up = neg
+				case ToPositiveInf:
+					up = !neg
+				case ToNearestTowardZero:
+					up = cmp > 0
+				case ToZero05Up:
+					digit := new(big.Int).Rem(whole, big.NewInt(10)).Int64()
+					up = digit == 0 || digit == 5
+				case ToOdd:
+					up = whole.Bit(0) == 0
+				}
+			}
+
+			want := new(big.Int).Set(whole)
+			if up {
+				want.Add(want, big.NewInt(1))
+			}
+
+			if neg {
+				want.Neg(want)
+			}
+
+			res := d.RoundToIncrement(inc, mode)
+
+			got := new(big.Rat).Quo(oracleRat(res), oracleRat(inc))
+			if !got.IsInt() || got.Num().Cmp(want) != 0 {
+				t.Errorf("%v.RoundToIncrement(%v, %v) = %v, want %v * %v", d, inc, mode, res, want, inc)
+			}
+
+			if _, exp := res.decompose(); !res.IsZero() && exp < exponentBias-int16(inc.Scale()) {
+				t.Errorf("%v.RoundToIncrement(%v, %v) = %v, has exponent below increment", d, inc, mode, res)
+			}
+		}
+	}
+}
+
 func BenchmarkReduce128(b *testing.B) {
 	initUintValues()
 
@@ -10,7 +10,7 @@ import (
 // MustParse is like [Parse] but panics if the provided string cannot be parsed,
 // instead of returning an error.
 func MustParse(s string) Decimal {
-	d, err := parse(s, payloadOpMustParse)
+	d, err := parse(s, payloadOpMustParse, DefaultRoundingMode)
 	if err != nil {
 		panic("decimal128.MustParse(" + strconv.Quote(s) + "): invalid syntax")
 	}
@@ -22,7 +22,8 @@ func MustParse(s string) Decimal {
 // floating point syntax. An underscore character '_' may appear between digits
 // as a separator. Parse also recognises the string "NaN", and the (possibly
 // signed) strings "Inf" and "Infinity", as their respective special floating
-// point values. It ignores case when matching.
+// point values, and the string "sNaN" as a signaling NaN. It ignores case when
+// matching.
 //
 // If s is not syntactically well-formed, Parse returns an error that can be
 // compared to [strconv.ErrSyntax] via [errors.Is].
@@ -32,11 +33,19 @@ func MustParse(s string) Decimal {
 // Decimal value, Parse returns ±Inf and an error that can be compared to
 // [strconv.ErrRange] via [errors.Is].
 func Parse(s string) (Decimal, error) {
-	return parse(s, payloadOpParse)
+	return parse(s, payloadOpParse, DefaultRoundingMode)
+}
+
+// ParseWithMode is like [Parse] but rounds the value using the provided
+// rounding mode if it is too precise to fit in a Decimal, instead of using the
+// [DefaultRoundingMode].
+func ParseWithMode(s string, mode RoundingMode) (Decimal, error) {
+	return parse(s, payloadOpParse, mode)
 }
 
 // Scan implements the [fmt.Scanner] interface. It supports the verbs 'e', 'E',
-// 'f', 'F', 'g', 'G', and 'v'.
+// 'f', 'F', 'g', 'G', and 'v'. Values that are too precise to fit in a Decimal
+// are rounded using the [DefaultRoundingMode].
 func (d *Decimal) Scan(f fmt.ScanState, verb rune) error {
 	switch verb {
 	case 'e', 'E', 'f', 'F', 'g', 'G', 'v':
@@ -133,6 +142,50 @@ func (d *Decimal) Scan(f fmt.ScanState, verb rune) error {
 		return nil
 	}
 
+	if r == 'S' || r == 's' {
+		r2, _, err := f.ReadRune()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return io.ErrUnexpectedEOF
+			}
+
+			return err
+		}
+
+		if r2 != 'N' && r2 != 'n' {
+			return &parseSyntaxError{s: string([]rune{r, r2})}
+		}
+
+		r3, _, err := f.ReadRune()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return io.ErrUnexpectedEOF
+			}
+
+			return err
+		}
+
+		if r3 != 'A' && r3 != 'a' {
+			return &parseSyntaxError{s: string([]rune{r, r2, r3})}
+		}
+
+		r4, _, err := f.ReadRune()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return io.ErrUnexpectedEOF
+			}
+
+			return err
+		}
+
+		if r4 != 'N' && r4 != 'n' {
+			return &parseSyntaxError{s: string([]rune{r, r2, r3, r4})}
+		}
+
+		*d = SignalingNaN(payloadOpScan)
+		return nil
+	}
+
 	f.UnreadRune()
 
 	tok, err := f.Token(false, func(r rune) bool {
@@ -158,7 +211,7 @@ func (d *Decimal) Scan(f fmt.ScanState, verb rune) error {
 		return err
 	}
 
-	tmp, err := parseNumber(tok, neg, true)
+	tmp, err := parseNumber(tok, neg, true, DefaultRoundingMode)
 	if err != nil {
 		switch err := err.(type) {
 		case parseNumberRangeError:
@@ -174,9 +227,11 @@ func (d *Decimal) Scan(f fmt.ScanState, verb rune) error {
 	return nil
 }
 
-// UnmarshalText implements the [encoding.TextUnmarshaler] interface.
+// UnmarshalText implements the [encoding.TextUnmarshaler] interface. Values
+// that are too precise to fit in a Decimal are rounded using the
+// [DefaultRoundingMode]; use [ParseWithMode] to choose a rounding mode instead.
 func (d *Decimal) UnmarshalText(data []byte) error {
-	tmp, err := parse(data, payloadOpUnmarshalText)
+	tmp, err := parse(data, payloadOpUnmarshalText, DefaultRoundingMode)
 	if err != nil {
 		return err
 	}
@@ -185,7 +240,7 @@ func (d *Decimal) UnmarshalText(data []byte) error {
 	return nil
 }
 
-func parse[D []byte | string](d D, op Payload) (Decimal, error) {
+func parse[D []byte | string](d D, op Payload, mode RoundingMode) (Decimal, error) {
 	if len(d) == 0 {
 		return Decimal{}, &parseSyntaxError{}
 	}
@@ -212,13 +267,17 @@ func parse[D []byte | string](d D, op Payload) (Decimal, error) {
 		if (d[0] == 'N' || d[0] == 'n') && (d[1] == 'A' || d[1] == 'a') && (d[2] == 'N' || d[2] == 'n') {
 			return nan(op, 0, 0), nil
 		}
+	} else if l == 4 {
+		if (d[0] == 'S' || d[0] == 's') && (d[1] == 'N' || d[1] == 'n') && (d[2] == 'A' || d[2] == 'a') && (d[3] == 'N' || d[3] == 'n') {
+			return SignalingNaN(op), nil
+		}
 	} else if l == 8 {
 		if (d[0] == 'I' || d[0] == 'i') && (d[1] == 'N' || d[1] == 'n') && (d[2] == 'F' || d[2] == 'f') && (d[3] == 'I' || d[3] == 'i') && (d[4] == 'N' || d[4] == 'n') && (d[5] == 'I' || d[5] == 'i') && (d[6] == 'T' || d[6] == 't') && (d[7] == 'Y' || d[7] == 'y') {
 			return inf(neg), nil
 		}
 	}
 
-	v, err := parseNumber(d, neg, true)
+	v, err := parseNumber(d, neg, true, mode)
 	if err != nil {
 		switch err := err.(type) {
 		case parseNumberRangeError:
@@ -233,7 +292,7 @@ func parse[D []byte | string](d D, op Payload) (Decimal, error) {
 	return v, nil
 }
 
-func parseNumber[D []byte | string](d D, neg, sepallowed bool) (Decimal, error) {
+func parseNumber[D []byte | string](d D, neg, sepallowed bool, mode RoundingMode) (Decimal, error) {
 	var sig64 uint64
 	var nfrac int16
 	var trunc int8
@@ -429,7 +488,7 @@ func parseNumber[D []byte | string](d D, neg, sepallowed bool) (Decimal, error)
 		return zero(neg), nil
 	}
 
-	sig, exp = DefaultRoundingMode.reduce128(neg, sig, exp+exponentBias, trunc)
+	sig, exp = mode.reduce128(neg, sig, exp+exponentBias, trunc)
 
 	if exp > maxBiasedExponent {
 		return inf(neg), parseNumberRangeError{}
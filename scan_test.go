@@ -30,6 +30,8 @@ var textValues = map[string]Decimal{
 	"-Infinity":   inf(true),
 	"nan":         NaN(),
 	"NaN":         NaN(),
+	"sNaN":        SignalingNaN(0),
+	"SNAN":        SignalingNaN(0),
 	"0e99999999":  zero(false),
 	"-0e99999999": zero(true),
 }
@@ -112,6 +114,26 @@ func TestParse(t *testing.T) {
 	}
 }
 
+func TestParseWithMode(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val string
+	var res testDataResult
+
+	for r.scan("%s = %v\n", &val, &res) {
+		for _, mode := range roundingModes {
+			num, err := ParseWithMode(val, mode)
+
+			if !res.equal(num, mode) || err != nil {
+				t.Errorf("ParseWithMode(%s, %v) = (%v, %v), want (%v, <nil>)", val, mode, num, err, res.result(mode))
+			}
+		}
+	}
+}
+
 func FuzzParse(f *testing.F) {
 	f.Add("123_456.789e10")
 	f.Add("+Inf")
@@ -0,0 +1,639 @@
+// Package stats provides descriptive statistics over sequences of Decimal
+// values.
+//
+// Intermediate results are calculated exactly, so each statistic is rounded
+// only once, when the final result is produced. Functions without a rounding
+// mode argument round using [decimal128.DefaultRoundingMode]. Each function
+// has a variant accepting a slice of values and a variant accepting an
+// [iter.Seq].
+package stats
+
+import (
+	"iter"
+	"math/big"
+	"slices"
+	"strconv"
+
+	"github.com/woodsbury/decimal128"
+)
+
+// LinearRegression returns the slope and intercept of the line that best fits
+// the points (xs[i], ys[i]) in the least squares sense, rounding using the
+// [decimal128.DefaultRoundingMode]. If xs and ys are different lengths, the
+// result is NaN.
+//
+// Special cases are:
+//
+//	LinearRegression(xs, ys) = NaN, NaN if fewer than 2 points are provided
+//	LinearRegression(xs, ys) = NaN, NaN if every x value is equal
+//	LinearRegression(xs, ys) = NaN, NaN if any value is NaN or infinite
+func LinearRegression(xs, ys []decimal128.Decimal) (decimal128.Decimal, decimal128.Decimal) {
+	return LinearRegressionWithMode(xs, ys, decimal128.DefaultRoundingMode)
+}
+
+// LinearRegressionSeq returns the slope and intercept of the line that best
+// fits the points (x, y) yielded by seq in the least squares sense, rounding
+// using the [decimal128.DefaultRoundingMode]. The special cases are the same
+// as [LinearRegression].
+func LinearRegressionSeq(seq iter.Seq2[decimal128.Decimal, decimal128.Decimal]) (decimal128.Decimal, decimal128.Decimal) {
+	return LinearRegressionSeqWithMode(seq, decimal128.DefaultRoundingMode)
+}
+
+// LinearRegressionSeqWithMode returns the slope and intercept of the line
+// that best fits the points (x, y) yielded by seq in the least squares sense,
+// rounding using the provided rounding mode. The special cases are the same as
+// [LinearRegression].
+func LinearRegressionSeqWithMode(seq iter.Seq2[decimal128.Decimal, decimal128.Decimal], mode decimal128.RoundingMode) (decimal128.Decimal, decimal128.Decimal) {
+	var x, y, xx, xy exactSum
+	var xSig, ySig, prd big.Int
+	var n int64
+
+	for xVal, yVal := range seq {
+		if !finite(xVal) || !finite(yVal) {
+			return decimal128.NaN(), decimal128.NaN()
+		}
+
+		xVal.Coefficient(&xSig)
+		yVal.Coefficient(&ySig)
+		xExp := xVal.Exponent()
+		yExp := yVal.Exponent()
+
+		x.add(&xSig, xExp)
+		y.add(&ySig, yExp)
+		xx.add(prd.Mul(&xSig, &xSig), 2*xExp)
+		xy.add(prd.Mul(&xSig, &ySig), xExp+yExp)
+		n++
+	}
+
+	if n < 2 {
+		return decimal128.NaN(), decimal128.NaN()
+	}
+
+	cnt := new(big.Rat).SetInt64(n)
+	xRat := x.rat()
+	yRat := y.rat()
+
+	// slope = (n·Σxy - Σx·Σy) / (n·Σx² - (Σx)²)
+	num := new(big.Rat).Mul(cnt, xy.rat())
+	num.Sub(num, new(big.Rat).Mul(xRat, yRat))
+
+	den := new(big.Rat).Mul(cnt, xx.rat())
+	den.Sub(den, new(big.Rat).Mul(xRat, xRat))
+
+	if den.Sign() == 0 {
+		return decimal128.NaN(), decimal128.NaN()
+	}
+
+	slope := num.Quo(num, den)
+
+	// intercept = (Σy - slope·Σx) / n
+	intercept := new(big.Rat).Mul(slope, xRat)
+	intercept.Sub(yRat, intercept)
+	intercept.Quo(intercept, cnt)
+
+	return round(slope, mode), round(intercept, mode)
+}
+
+// LinearRegressionWithMode returns the slope and intercept of the line that
+// best fits the points (xs[i], ys[i]) in the least squares sense, rounding
+// using the provided rounding mode. The special cases are the same as
+// [LinearRegression].
+func LinearRegressionWithMode(xs, ys []decimal128.Decimal, mode decimal128.RoundingMode) (decimal128.Decimal, decimal128.Decimal) {
+	if len(xs) != len(ys) {
+		return decimal128.NaN(), decimal128.NaN()
+	}
+
+	return LinearRegressionSeqWithMode(func(yield func(decimal128.Decimal, decimal128.Decimal) bool) {
+		for i := range xs {
+			if !yield(xs[i], ys[i]) {
+				return
+			}
+		}
+	}, mode)
+}
+
+// Max returns the largest of values. If any value is NaN, or values is empty,
+// the result is NaN.
+func Max(values []decimal128.Decimal) decimal128.Decimal {
+	return MaxSeq(slices.Values(values))
+}
+
+// MaxSeq returns the largest of the values yielded by seq. If any value is
+// NaN, or seq yields no values, the result is NaN.
+func MaxSeq(seq iter.Seq[decimal128.Decimal]) decimal128.Decimal {
+	res := decimal128.NaN()
+	first := true
+
+	for val := range seq {
+		if first {
+			res = decimal128.Max(val, val)
+			first = false
+		} else {
+			res = decimal128.Max(res, val)
+		}
+
+		if res.IsNaN() {
+			break
+		}
+	}
+
+	return res
+}
+
+// Mean returns the arithmetic mean of values, rounding using the
+// [decimal128.DefaultRoundingMode].
+//
+// Special cases are:
+//
+//	Mean(values) = NaN if values is empty
+//	Mean(values) = NaN if any value is NaN
+//	Mean(values) = NaN if values contains both +Inf and -Inf
+//	Mean(values) = ±Inf if values contains ±Inf
+func Mean(values []decimal128.Decimal) decimal128.Decimal {
+	return MeanSeqWithMode(slices.Values(values), decimal128.DefaultRoundingMode)
+}
+
+// MeanSeq returns the arithmetic mean of the values yielded by seq, rounding
+// using the [decimal128.DefaultRoundingMode]. The special cases are the same
+// as [Mean].
+func MeanSeq(seq iter.Seq[decimal128.Decimal]) decimal128.Decimal {
+	return MeanSeqWithMode(seq, decimal128.DefaultRoundingMode)
+}
+
+// MeanSeqWithMode returns the arithmetic mean of the values yielded by seq,
+// rounding using the provided rounding mode. The special cases are the same
+// as [Mean].
+func MeanSeqWithMode(seq iter.Seq[decimal128.Decimal], mode decimal128.RoundingMode) decimal128.Decimal {
+	var sum exactSum
+	var sig big.Int
+	var n int64
+	var posInf, negInf bool
+
+	for val := range seq {
+		n++
+
+		if !finite(val) {
+			if val.IsNaN() {
+				return decimal128.NaN()
+			}
+
+			if val.Signbit() {
+				negInf = true
+			} else {
+				posInf = true
+			}
+
+			continue
+		}
+
+		sum.add(val.Coefficient(&sig), val.Exponent())
+	}
+
+	if n == 0 || posInf && negInf {
+		return decimal128.NaN()
+	}
+
+	if posInf {
+		return decimal128.Inf(1)
+	}
+
+	if negInf {
+		return decimal128.Inf(-1)
+	}
+
+	res := sum.rat()
+	return round(res.Quo(res, new(big.Rat).SetInt64(n)), mode)
+}
+
+// MeanWithMode returns the arithmetic mean of values, rounding using the
+// provided rounding mode. The special cases are the same as [Mean].
+func MeanWithMode(values []decimal128.Decimal, mode decimal128.RoundingMode) decimal128.Decimal {
+	return MeanSeqWithMode(slices.Values(values), mode)
+}
+
+// Median returns the median of values, rounding using the
+// [decimal128.DefaultRoundingMode]. If values has an even number of elements,
+// the median is the mean of the two middle values. It is equivalent to
+// Percentile(values, 50).
+//
+// Special cases are:
+//
+//	Median(values) = NaN if values is empty
+//	Median(values) = NaN if any value is NaN
+func Median(values []decimal128.Decimal) decimal128.Decimal {
+	return MedianWithMode(values, decimal128.DefaultRoundingMode)
+}
+
+// MedianSeq returns the median of the values yielded by seq, rounding using
+// the [decimal128.DefaultRoundingMode]. The special cases are the same as
+// [Median].
+func MedianSeq(seq iter.Seq[decimal128.Decimal]) decimal128.Decimal {
+	return MedianSeqWithMode(seq, decimal128.DefaultRoundingMode)
+}
+
+// MedianSeqWithMode returns the median of the values yielded by seq,
+// rounding using the provided rounding mode. The special cases are the same as
+// [Median].
+func MedianSeqWithMode(seq iter.Seq[decimal128.Decimal], mode decimal128.RoundingMode) decimal128.Decimal {
+	return percentile(slices.Collect(seq), big.NewRat(1, 2), mode)
+}
+
+// MedianWithMode returns the median of values, rounding using the provided
+// rounding mode. The special cases are the same as [Median].
+func MedianWithMode(values []decimal128.Decimal, mode decimal128.RoundingMode) decimal128.Decimal {
+	return percentile(slices.Clone(values), big.NewRat(1, 2), mode)
+}
+
+// Min returns the smallest of values. If any value is NaN, or values is
+// empty, the result is NaN.
+func Min(values []decimal128.Decimal) decimal128.Decimal {
+	return MinSeq(slices.Values(values))
+}
+
+// MinSeq returns the smallest of the values yielded by seq. If any value is
+// NaN, or seq yields no values, the result is NaN.
+func MinSeq(seq iter.Seq[decimal128.Decimal]) decimal128.Decimal {
+	res := decimal128.NaN()
+	first := true
+
+	for val := range seq {
+		if first {
+			res = decimal128.Min(val, val)
+			first = false
+		} else {
+			res = decimal128.Min(res, val)
+		}
+
+		if res.IsNaN() {
+			break
+		}
+	}
+
+	return res
+}
+
+// Percentile returns the p-th percentile of values, where p is between 0 and
+// 100, rounding using the [decimal128.DefaultRoundingMode]. When the
+// percentile falls between two values, the result is linearly interpolated
+// between them, matching the PERCENTILE.INC function found in spreadsheets.
+//
+// Special cases are:
+//
+//	Percentile(values, p) = NaN if values is empty
+//	Percentile(values, p) = NaN if any value is NaN
+//	Percentile(values, p) = NaN if p is NaN, less than 0 or greater than 100
+func Percentile(values []decimal128.Decimal, p decimal128.Decimal) decimal128.Decimal {
+	return PercentileWithMode(values, p, decimal128.DefaultRoundingMode)
+}
+
+// PercentileSeq returns the p-th percentile of the values yielded by seq,
+// where p is between 0 and 100, rounding using the
+// [decimal128.DefaultRoundingMode]. The special cases are the same as
+// [Percentile].
+func PercentileSeq(seq iter.Seq[decimal128.Decimal], p decimal128.Decimal) decimal128.Decimal {
+	return PercentileSeqWithMode(seq, p, decimal128.DefaultRoundingMode)
+}
+
+// PercentileSeqWithMode returns the p-th percentile of the values yielded by
+// seq, where p is between 0 and 100, rounding using the provided rounding
+// mode. The special cases are the same as [Percentile].
+func PercentileSeqWithMode(seq iter.Seq[decimal128.Decimal], p decimal128.Decimal, mode decimal128.RoundingMode) decimal128.Decimal {
+	frac, ok := percentileFraction(p)
+	if !ok {
+		return decimal128.NaN()
+	}
+
+	return percentile(slices.Collect(seq), frac, mode)
+}
+
+// PercentileWithMode returns the p-th percentile of values, where p is
+// between 0 and 100, rounding using the provided rounding mode. The special
+// cases are the same as [Percentile].
+func PercentileWithMode(values []decimal128.Decimal, p decimal128.Decimal, mode decimal128.RoundingMode) decimal128.Decimal {
+	frac, ok := percentileFraction(p)
+	if !ok {
+		return decimal128.NaN()
+	}
+
+	return percentile(slices.Clone(values), frac, mode)
+}
+
+// StdDev returns the sample standard deviation of values, rounding using the
+// [decimal128.DefaultRoundingMode]. This is the square root of [Variance].
+//
+// Special cases are:
+//
+//	StdDev(values) = NaN if values has fewer than 2 elements
+//	StdDev(values) = NaN if any value is NaN or infinite
+func StdDev(values []decimal128.Decimal) decimal128.Decimal {
+	return StdDevSeqWithMode(slices.Values(values), decimal128.DefaultRoundingMode)
+}
+
+// StdDevSeq returns the sample standard deviation of the values yielded by
+// seq, rounding using the [decimal128.DefaultRoundingMode]. The special cases
+// are the same as [StdDev].
+func StdDevSeq(seq iter.Seq[decimal128.Decimal]) decimal128.Decimal {
+	return StdDevSeqWithMode(seq, decimal128.DefaultRoundingMode)
+}
+
+// StdDevSeqWithMode returns the sample standard deviation of the values
+// yielded by seq, rounding using the provided rounding mode. The special cases
+// are the same as [StdDev].
+func StdDevSeqWithMode(seq iter.Seq[decimal128.Decimal], mode decimal128.RoundingMode) decimal128.Decimal {
+	res, ok := variance(seq)
+	if !ok {
+		return decimal128.NaN()
+	}
+
+	return roundSqrt(res, mode)
+}
+
+// StdDevWithMode returns the sample standard deviation of values, rounding
+// using the provided rounding mode. The special cases are the same as
+// [StdDev].
+func StdDevWithMode(values []decimal128.Decimal, mode decimal128.RoundingMode) decimal128.Decimal {
+	return StdDevSeqWithMode(slices.Values(values), mode)
+}
+
+// Variance returns the sample variance of values, rounding using the
+// [decimal128.DefaultRoundingMode]. The sample variance divides the sum of the
+// squared deviations from the mean by one less than the number of values.
+//
+// Special cases are:
+//
+//	Variance(values) = NaN if values has fewer than 2 elements
+//	Variance(values) = NaN if any value is NaN or infinite
+func Variance(values []decimal128.Decimal) decimal128.Decimal {
+	return VarianceSeqWithMode(slices.Values(values), decimal128.DefaultRoundingMode)
+}
+
+// VarianceSeq returns the sample variance of the values yielded by seq,
+// rounding using the [decimal128.DefaultRoundingMode]. The special cases are
+// the same as [Variance].
+func VarianceSeq(seq iter.Seq[decimal128.Decimal]) decimal128.Decimal {
+	return VarianceSeqWithMode(seq, decimal128.DefaultRoundingMode)
+}
+
+// VarianceSeqWithMode returns the sample variance of the values yielded by
+// seq, rounding using the provided rounding mode. The special cases are the
+// same as [Variance].
+func VarianceSeqWithMode(seq iter.Seq[decimal128.Decimal], mode decimal128.RoundingMode) decimal128.Decimal {
+	res, ok := variance(seq)
+	if !ok {
+		return decimal128.NaN()
+	}
+
+	return round(res, mode)
+}
+
+// VarianceWithMode returns the sample variance of values, rounding using the
+// provided rounding mode. The special cases are the same as [Variance].
+func VarianceWithMode(values []decimal128.Decimal, mode decimal128.RoundingMode) decimal128.Decimal {
+	return VarianceSeqWithMode(slices.Values(values), mode)
+}
+
+func finite(d decimal128.Decimal) bool {
+	return !d.IsNaN() && !d.IsInf(0)
+}
+
+func percentile(values []decimal128.Decimal, frac *big.Rat, mode decimal128.RoundingMode) decimal128.Decimal {
+	if len(values) == 0 {
+		return decimal128.NaN()
+	}
+
+	for _, val := range values {
+		if val.IsNaN() {
+			return decimal128.NaN()
+		}
+	}
+
+	slices.SortFunc(values, decimal128.Compare)
+
+	// The percentile lies at rank frac·(n - 1), between the values at the
+	// integer part of the rank and the one following it.
+	rank := new(big.Rat).Mul(frac, new(big.Rat).SetInt64(int64(len(values)-1)))
+	idx := new(big.Int).Quo(rank.Num(), rank.Denom())
+	rank.Sub(rank, new(big.Rat).SetInt(idx))
+
+	lo := values[idx.Int64()]
+
+	if rank.Sign() == 0 {
+		return lo
+	}
+
+	hi := values[idx.Int64()+1]
+
+	if lo.Equal(hi) {
+		return lo
+	}
+
+	if !finite(lo) || !finite(hi) {
+		// Interpolating towards or away from an infinity gives that infinity,
+		// unless both values are infinite.
+		if lo.IsInf(-1) && hi.IsInf(1) {
+			return decimal128.NaN()
+		}
+
+		if lo.IsInf(0) {
+			return lo
+		}
+
+		return hi
+	}
+
+	res := hi.Rat(nil)
+	res.Sub(res, lo.Rat(nil))
+	res.Mul(res, rank)
+	res.Add(res, lo.Rat(nil))
+
+	return round(res, mode)
+}
+
+func percentileFraction(p decimal128.Decimal) (*big.Rat, bool) {
+	if !finite(p) || p.Signbit() && !p.IsZero() {
+		return nil, false
+	}
+
+	frac := p.Rat(nil)
+	frac.Quo(frac, big.NewRat(100, 1))
+
+	if frac.Cmp(big.NewRat(1, 1)) > 0 {
+		return nil, false
+	}
+
+	return frac, true
+}
+
+func variance(seq iter.Seq[decimal128.Decimal]) (*big.Rat, bool) {
+	var sum, sqr exactSum
+	var sig, prd big.Int
+	var n int64
+
+	for val := range seq {
+		if !finite(val) {
+			return nil, false
+		}
+
+		val.Coefficient(&sig)
+		exp := val.Exponent()
+
+		sum.add(&sig, exp)
+		sqr.add(prd.Mul(&sig, &sig), 2*exp)
+		n++
+	}
+
+	if n < 2 {
+		return nil, false
+	}
+
+	// variance = (n·Σx² - (Σx)²) / (n·(n - 1))
+	res := sqr.rat()
+	res.Mul(res, new(big.Rat).SetInt64(n))
+
+	tot := sum.rat()
+	res.Sub(res, tot.Mul(tot, tot))
+
+	return res.Quo(res, new(big.Rat).SetInt64(n*(n-1))), true
+}
+
+// exactSum holds the exact sum of a sequence of finite values as an integer
+// coefficient and a power of ten exponent.
+type exactSum struct {
+	sig big.Int
+	exp int
+	tmp big.Int
+}
+
+func (s *exactSum) add(sig *big.Int, exp int) {
+	if sig.Sign() == 0 {
+		return
+	}
+
+	if s.sig.Sign() == 0 {
+		s.sig.Set(sig)
+		s.exp = exp
+		return
+	}
+
+	if exp < s.exp {
+		s.sig.Mul(&s.sig, pow10(s.exp-exp))
+		s.sig.Add(&s.sig, sig)
+		s.exp = exp
+		return
+	}
+
+	s.tmp.Mul(sig, pow10(exp-s.exp))
+	s.sig.Add(&s.sig, &s.tmp)
+}
+
+func (s *exactSum) rat() *big.Rat {
+	res := new(big.Rat).SetInt(&s.sig)
+
+	if s.exp > 0 {
+		return res.Mul(res, new(big.Rat).SetInt(pow10(s.exp)))
+	}
+
+	return res.Quo(res, new(big.Rat).SetInt(pow10(-s.exp)))
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// roundDigits is the number of digits calculated before rounding, which is
+// enough to round correctly to the 35 digits a Decimal can hold.
+const roundDigits = 40
+
+// round rounds r to a Decimal using the provided rounding mode. The first
+// roundDigits digits of r are calculated exactly, followed by an extra
+// non-zero digit if any of the remaining digits are non-zero, which is then
+// parsed to round the value correctly.
+func round(r *big.Rat, mode decimal128.RoundingMode) decimal128.Decimal {
+	if r.Sign() == 0 {
+		return decimal128.New(0, 0)
+	}
+
+	num := new(big.Int).Abs(r.Num())
+	den := new(big.Int).Set(r.Denom())
+
+	exp := roundDigits - digits(num) + digits(den)
+	scale(num, den, exp)
+
+	sig, rem := num.QuoRem(num, den, new(big.Int))
+	return parse(r.Sign() < 0, sig, exp, rem.Sign() != 0, mode)
+}
+
+// roundSqrt rounds the square root of r to a Decimal using the provided
+// rounding mode, in the same way as round.
+func roundSqrt(r *big.Rat, mode decimal128.RoundingMode) decimal128.Decimal {
+	if r.Sign() == 0 {
+		return decimal128.New(0, 0)
+	}
+
+	num := new(big.Int).Set(r.Num())
+	den := new(big.Int).Set(r.Denom())
+
+	exp := roundDigits - (digits(num)-digits(den))/2
+	scale(num, den, 2*exp)
+
+	sqr, rem := num.QuoRem(num, den, new(big.Int))
+	sig := new(big.Int).Sqrt(sqr)
+	inexact := rem.Sign() != 0 || new(big.Int).Mul(sig, sig).Cmp(sqr) != 0
+
+	return parse(false, sig, exp, inexact, mode)
+}
+
+// digits returns an estimate of the number of decimal digits in n, which may
+// be one less than the actual number.
+func digits(n *big.Int) int {
+	return n.BitLen() * 30103 / 100000
+}
+
+// scale multiplies the fraction num / den by 10**exp.
+func scale(num, den *big.Int, exp int) {
+	if exp > 0 {
+		num.Mul(num, pow10(exp))
+	} else if exp < 0 {
+		den.Mul(den, pow10(-exp))
+	}
+}
+
+func parse(neg bool, sig *big.Int, exp int, inexact bool, mode decimal128.RoundingMode) decimal128.Decimal {
+	if !inexact {
+		// Remove any trailing zeros after the decimal point, so that exact
+		// results are returned with as few digits as possible.
+		ten := big.NewInt(10)
+		quo, rem := new(big.Int), new(big.Int)
+
+		for exp > 0 {
+			quo.QuoRem(sig, ten, rem)
+			if rem.Sign() != 0 {
+				break
+			}
+
+			sig, quo = quo, sig
+			exp--
+		}
+	}
+
+	buf := make([]byte, 0, roundDigits+16)
+
+	if neg {
+		buf = append(buf, '-')
+	}
+
+	buf = sig.Append(buf, 10)
+
+	if inexact {
+		buf = append(buf, '1')
+		exp++
+	}
+
+	buf = append(buf, 'e')
+	buf = strconv.AppendInt(buf, int64(-exp), 10)
+
+	// Values outside of the range of a Decimal return an error alongside an
+	// infinite or zero result, which is the correct result here.
+	res, _ := decimal128.ParseWithMode(string(buf), mode)
+	return res
+}
@@ -0,0 +1,305 @@
+package stats
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/woodsbury/decimal128"
+)
+
+func decimals(values ...string) []decimal128.Decimal {
+	res := make([]decimal128.Decimal, len(values))
+	for i, val := range values {
+		res[i] = decimal128.MustParse(val)
+	}
+
+	return res
+}
+
+func resultEqual(x, y decimal128.Decimal) bool {
+	if x.IsNaN() && y.IsNaN() {
+		return true
+	}
+
+	return x.Signbit() == y.Signbit() && x.Equal(y)
+}
+
+func TestLinearRegression(t *testing.T) {
+	t.Parallel()
+
+	values := []struct {
+		xs        []decimal128.Decimal
+		ys        []decimal128.Decimal
+		mode      decimal128.RoundingMode
+		slope     string
+		intercept string
+	}{
+		{decimals("1", "2", "3", "4", "5"), decimals("2", "4", "5", "4", "5"), decimal128.ToNearestEven, "0.6", "2.2"},
+		{decimals("0", "1", "3"), decimals("0", "1", "1"), decimal128.ToNearestEven, "0.2857142857142857142857142857142857", "0.2857142857142857142857142857142857"},
+		{decimals("0", "1", "3"), decimals("0", "1", "1"), decimal128.ToPositiveInf, "0.2857142857142857142857142857142858", "0.2857142857142857142857142857142858"},
+		{decimals("1e-20", "2e-20"), decimals("1e20", "3e20"), decimal128.ToNearestEven, "2e40", "-1e20"},
+		{decimals("1"), decimals("1"), decimal128.ToNearestEven, "NaN", "NaN"},
+		{decimals("1", "1", "1"), decimals("1", "2", "3"), decimal128.ToNearestEven, "NaN", "NaN"},
+		{decimals("1", "2"), decimals("1", "2", "3"), decimal128.ToNearestEven, "NaN", "NaN"},
+		{decimals("1", "2", "Inf"), decimals("1", "2", "3"), decimal128.ToNearestEven, "NaN", "NaN"},
+		{decimals("1", "2", "3"), decimals("1", "NaN", "3"), decimal128.ToNearestEven, "NaN", "NaN"},
+	}
+
+	for _, val := range values {
+		slope, intercept := LinearRegressionWithMode(val.xs, val.ys, val.mode)
+		wantSlope := decimal128.MustParse(val.slope)
+		wantIntercept := decimal128.MustParse(val.intercept)
+
+		if !resultEqual(slope, wantSlope) || !resultEqual(intercept, wantIntercept) {
+			t.Errorf("LinearRegressionWithMode(%v, %v, %v) = (%v, %v), want (%v, %v)", val.xs, val.ys, val.mode, slope, intercept, wantSlope, wantIntercept)
+		}
+
+		if len(val.xs) != len(val.ys) {
+			continue
+		}
+
+		slope, intercept = LinearRegressionSeqWithMode(func(yield func(decimal128.Decimal, decimal128.Decimal) bool) {
+			for i := range val.xs {
+				if !yield(val.xs[i], val.ys[i]) {
+					return
+				}
+			}
+		}, val.mode)
+
+		if !resultEqual(slope, wantSlope) || !resultEqual(intercept, wantIntercept) {
+			t.Errorf("LinearRegressionSeqWithMode(%v, %v, %v) = (%v, %v), want (%v, %v)", val.xs, val.ys, val.mode, slope, intercept, wantSlope, wantIntercept)
+		}
+	}
+}
+
+func TestMax(t *testing.T) {
+	t.Parallel()
+
+	values := []struct {
+		values []decimal128.Decimal
+		res    string
+	}{
+		{decimals("3", "-1", "2"), "3"},
+		{decimals("-0", "0"), "0"},
+		{decimals("1", "Inf"), "Inf"},
+		{decimals("1", "NaN", "2"), "NaN"},
+		{nil, "NaN"},
+	}
+
+	for _, val := range values {
+		want := decimal128.MustParse(val.res)
+
+		if res := Max(val.values); !resultEqual(res, want) {
+			t.Errorf("Max(%v) = %v, want %v", val.values, res, want)
+		}
+
+		if res := MaxSeq(slices.Values(val.values)); !resultEqual(res, want) {
+			t.Errorf("MaxSeq(%v) = %v, want %v", val.values, res, want)
+		}
+	}
+
+	for _, values := range [][]decimal128.Decimal{
+		{decimal128.SignalingNaN(3)},
+		{decimal128.SignalingNaN(3), decimal128.New(1, 0)},
+		{decimal128.New(1, 0), decimal128.SignalingNaN(3)},
+	} {
+		if res := Max(values); !res.IsNaN() || res.IsSignalingNaN() {
+			t.Errorf("Max(%v) = %v, want quiet NaN", values, res)
+		}
+	}
+}
+
+func TestMean(t *testing.T) {
+	t.Parallel()
+
+	values := []struct {
+		values []decimal128.Decimal
+		mode   decimal128.RoundingMode
+		res    string
+	}{
+		{decimals("1", "2", "3", "4"), decimal128.ToNearestEven, "2.5"},
+		{decimals("1.50", "2.50"), decimal128.ToNearestEven, "2"},
+		{decimals("1", "2", "2"), decimal128.ToNearestEven, "1.666666666666666666666666666666667"},
+		{decimals("1", "2", "2"), decimal128.ToZero, "1.666666666666666666666666666666666"},
+		{decimals("-1", "-2", "-2"), decimal128.ToPositiveInf, "-1.666666666666666666666666666666666"},
+		{decimals("1e34", "1", "-1e34"), decimal128.ToNearestEven, "0.3333333333333333333333333333333333"},
+		{decimals("1e6144", "1e6144"), decimal128.ToNearestEven, "1e6144"},
+		{decimals("1", "Inf"), decimal128.ToNearestEven, "Inf"},
+		{decimals("-Inf", "1"), decimal128.ToNearestEven, "-Inf"},
+		{decimals("Inf", "-Inf"), decimal128.ToNearestEven, "NaN"},
+		{decimals("1", "NaN"), decimal128.ToNearestEven, "NaN"},
+		{nil, decimal128.ToNearestEven, "NaN"},
+	}
+
+	for _, val := range values {
+		want := decimal128.MustParse(val.res)
+
+		if res := MeanWithMode(val.values, val.mode); !resultEqual(res, want) {
+			t.Errorf("MeanWithMode(%v, %v) = %v, want %v", val.values, val.mode, res, want)
+		}
+
+		if res := MeanSeqWithMode(slices.Values(val.values), val.mode); !resultEqual(res, want) {
+			t.Errorf("MeanSeqWithMode(%v, %v) = %v, want %v", val.values, val.mode, res, want)
+		}
+	}
+}
+
+func TestMedian(t *testing.T) {
+	t.Parallel()
+
+	values := []struct {
+		values []decimal128.Decimal
+		mode   decimal128.RoundingMode
+		res    string
+	}{
+		{decimals("3", "1", "2"), decimal128.ToNearestEven, "2"},
+		{decimals("4", "1", "3", "2"), decimal128.ToNearestEven, "2.5"},
+		{decimals("1", "2e-34"), decimal128.ToNearestEven, "0.5000000000000000000000000000000001"},
+		{decimals("1", "2e-40"), decimal128.ToNearestEven, "0.5"},
+		{decimals("1", "2e-40"), decimal128.AwayFromZero, "0.5000000000000000000000000000000001"},
+		{decimals("1", "Inf"), decimal128.ToNearestEven, "Inf"},
+		{decimals("-Inf", "Inf"), decimal128.ToNearestEven, "NaN"},
+		{decimals("1", "NaN", "2"), decimal128.ToNearestEven, "NaN"},
+		{nil, decimal128.ToNearestEven, "NaN"},
+	}
+
+	for _, val := range values {
+		want := decimal128.MustParse(val.res)
+		orig := slices.Clone(val.values)
+
+		if res := MedianWithMode(val.values, val.mode); !resultEqual(res, want) {
+			t.Errorf("MedianWithMode(%v, %v) = %v, want %v", val.values, val.mode, res, want)
+		}
+
+		if !slices.Equal(val.values, orig) {
+			t.Errorf("MedianWithMode(%v, %v) modified its argument", orig, val.mode)
+		}
+
+		if res := MedianSeqWithMode(slices.Values(val.values), val.mode); !resultEqual(res, want) {
+			t.Errorf("MedianSeqWithMode(%v, %v) = %v, want %v", val.values, val.mode, res, want)
+		}
+	}
+}
+
+func TestMin(t *testing.T) {
+	t.Parallel()
+
+	values := []struct {
+		values []decimal128.Decimal
+		res    string
+	}{
+		{decimals("3", "-1", "2"), "-1"},
+		{decimals("0", "-0"), "-0"},
+		{decimals("1", "-Inf"), "-Inf"},
+		{decimals("1", "NaN", "2"), "NaN"},
+		{nil, "NaN"},
+	}
+
+	for _, val := range values {
+		want := decimal128.MustParse(val.res)
+
+		if res := Min(val.values); !resultEqual(res, want) {
+			t.Errorf("Min(%v) = %v, want %v", val.values, res, want)
+		}
+
+		if res := MinSeq(slices.Values(val.values)); !resultEqual(res, want) {
+			t.Errorf("MinSeq(%v) = %v, want %v", val.values, res, want)
+		}
+	}
+
+	for _, values := range [][]decimal128.Decimal{
+		{decimal128.SignalingNaN(3)},
+		{decimal128.SignalingNaN(3), decimal128.New(1, 0)},
+		{decimal128.New(1, 0), decimal128.SignalingNaN(3)},
+	} {
+		if res := Min(values); !res.IsNaN() || res.IsSignalingNaN() {
+			t.Errorf("Min(%v) = %v, want quiet NaN", values, res)
+		}
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	t.Parallel()
+
+	values := []struct {
+		values []decimal128.Decimal
+		p      string
+		mode   decimal128.RoundingMode
+		res    string
+	}{
+		{decimals("50", "15", "40", "20", "35"), "0", decimal128.ToNearestEven, "15"},
+		{decimals("50", "15", "40", "20", "35"), "40", decimal128.ToNearestEven, "29"},
+		{decimals("50", "15", "40", "20", "35"), "50", decimal128.ToNearestEven, "35"},
+		{decimals("50", "15", "40", "20", "35"), "100", decimal128.ToNearestEven, "50"},
+		{decimals("1", "2", "3", "4"), "10", decimal128.ToNearestEven, "1.3"},
+		{decimals("0", "1"), "33.3333333333", decimal128.ToNearestEven, "0.333333333333"},
+		{decimals("0", "1", "2", "3"), "10", decimal128.ToNearestEven, "0.3"},
+		{decimals("0", "1", "2", "3", "4", "5", "6"), "10", decimal128.ToNearestEven, "0.6"},
+		{decimals("0", "1", "2"), "1", decimal128.ToNearestEven, "0.02"},
+		{decimals("0", "1e-40"), "50", decimal128.ToNearestEven, "5e-41"},
+		{decimals("-Inf", "1", "2"), "25", decimal128.ToNearestEven, "-Inf"},
+		{decimals("1", "2", "Inf"), "75", decimal128.ToNearestEven, "Inf"},
+		{decimals("1", "2"), "-1", decimal128.ToNearestEven, "NaN"},
+		{decimals("1", "2"), "101", decimal128.ToNearestEven, "NaN"},
+		{decimals("1", "2"), "NaN", decimal128.ToNearestEven, "NaN"},
+		{decimals("1", "NaN"), "50", decimal128.ToNearestEven, "NaN"},
+		{nil, "50", decimal128.ToNearestEven, "NaN"},
+	}
+
+	for _, val := range values {
+		p := decimal128.MustParse(val.p)
+		want := decimal128.MustParse(val.res)
+
+		if res := PercentileWithMode(val.values, p, val.mode); !resultEqual(res, want) {
+			t.Errorf("PercentileWithMode(%v, %v, %v) = %v, want %v", val.values, p, val.mode, res, want)
+		}
+
+		if res := PercentileSeqWithMode(slices.Values(val.values), p, val.mode); !resultEqual(res, want) {
+			t.Errorf("PercentileSeqWithMode(%v, %v, %v) = %v, want %v", val.values, p, val.mode, res, want)
+		}
+	}
+}
+
+func TestVariance(t *testing.T) {
+	t.Parallel()
+
+	values := []struct {
+		values   []decimal128.Decimal
+		mode     decimal128.RoundingMode
+		variance string
+		stdDev   string
+	}{
+		{decimals("2", "4", "4", "4", "5", "5", "7", "9"), decimal128.ToNearestEven, "4.571428571428571428571428571428571", "2.138089935299395077476427847038028"},
+		{decimals("2", "4", "4", "4", "5", "5", "7", "9"), decimal128.AwayFromZero, "4.571428571428571428571428571428572", "2.138089935299395077476427847038029"},
+		{decimals("1", "3"), decimal128.ToNearestEven, "2", "1.414213562373095048801688724209698"},
+		{decimals("1", "3"), decimal128.ToPositiveInf, "2", "1.414213562373095048801688724209699"},
+		{decimals("10.5", "12.5", "14.5"), decimal128.ToNearestEven, "4", "2"},
+		{decimals("1e20", "1e20", "1e20"), decimal128.ToNearestEven, "0", "0"},
+		{decimals("1e20", "1", "1e20"), decimal128.ToNearestEven, "3.333333333333333333266666666666667e39", "5.773502691896257645033752778100612e19"},
+		{decimals("1"), decimal128.ToNearestEven, "NaN", "NaN"},
+		{decimals("1", "Inf"), decimal128.ToNearestEven, "NaN", "NaN"},
+		{decimals("1", "NaN"), decimal128.ToNearestEven, "NaN", "NaN"},
+	}
+
+	for _, val := range values {
+		want := decimal128.MustParse(val.variance)
+
+		if res := VarianceWithMode(val.values, val.mode); !resultEqual(res, want) {
+			t.Errorf("VarianceWithMode(%v, %v) = %v, want %v", val.values, val.mode, res, want)
+		}
+
+		if res := VarianceSeqWithMode(slices.Values(val.values), val.mode); !resultEqual(res, want) {
+			t.Errorf("VarianceSeqWithMode(%v, %v) = %v, want %v", val.values, val.mode, res, want)
+		}
+
+		want = decimal128.MustParse(val.stdDev)
+
+		if res := StdDevWithMode(val.values, val.mode); !resultEqual(res, want) {
+			t.Errorf("StdDevWithMode(%v, %v) = %v, want %v", val.values, val.mode, res, want)
+		}
+
+		if res := StdDevSeqWithMode(slices.Values(val.values), val.mode); !resultEqual(res, want) {
+			t.Errorf("StdDevSeqWithMode(%v, %v) = %v, want %v", val.values, val.mode, res, want)
+		}
+	}
+}
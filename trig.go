@@ -0,0 +1,584 @@
+package decimal128
+
+import "math/bits"
+
+// Acos returns the arccosine, in radians, of d, rounded using the
+// [DefaultRoundingMode].
+func Acos(d Decimal) Decimal {
+	return AcosWithMode(d, DefaultRoundingMode)
+}
+
+// AcosWithMode returns the arccosine, in radians, of d, rounded using the
+// provided rounding mode.
+//
+// If d is NaN or has a magnitude greater than 1, the result is NaN.
+func AcosWithMode(d Decimal, mode RoundingMode) Decimal {
+	if d.isSpecial() {
+		if d.IsNaN() {
+			return d.quiet()
+		}
+
+		return nan(payloadOpAcos, payloadVal(d), 0)
+	}
+
+	dSig, dExp := d.decompose()
+
+	if dSig[0]|dSig[1] == 0 {
+		sig, exp := mode.reduce192(false, halfPi.sig, halfPi.exp+exponentBias, 1)
+		return compose(false, sig, exp)
+	}
+
+	val := decomposed192{
+		sig: uint192{dSig[0], dSig[1], 0},
+		exp: dExp - exponentBias,
+	}
+
+	neg, omx, _ := val.sub1(0)
+	if !neg {
+		if omx.sig[0]|omx.sig[1]|omx.sig[2] != 0 {
+			return nan(payloadOpAcos, payloadVal(d), 0)
+		}
+
+		if !d.Signbit() {
+			return zero(false)
+		}
+
+		pi, trunc := halfPi.add(halfPi, 1)
+		sig, exp := mode.reduce192(false, pi.sig, pi.exp+exponentBias, trunc)
+		return compose(false, sig, exp)
+	}
+
+	// acos(x) = 2 * atan(sqrt((1 - x) / (1 + x)))
+	opx, _ := val.add1(0)
+
+	var res decomposed192
+	var trunc int8
+	if d.Signbit() {
+		res, trunc = opx.quo(omx, 0)
+	} else {
+		res, trunc = omx.quo(opx, 0)
+	}
+
+	res, trunc = res.sqrt()
+	res, trunc = res.atan(trunc)
+	res, trunc = res.add(res, trunc)
+
+	sig, exp := mode.reduce192(false, res.sig, res.exp+exponentBias, trunc)
+	return compose(false, sig, exp)
+}
+
+// Asin returns the arcsine, in radians, of d, rounded using the
+// [DefaultRoundingMode].
+func Asin(d Decimal) Decimal {
+	return AsinWithMode(d, DefaultRoundingMode)
+}
+
+// AsinWithMode returns the arcsine, in radians, of d, rounded using the
+// provided rounding mode.
+//
+// If d is NaN or has a magnitude greater than 1, the result is NaN.
+func AsinWithMode(d Decimal, mode RoundingMode) Decimal {
+	if d.isSpecial() {
+		if d.IsNaN() {
+			return d.quiet()
+		}
+
+		return nan(payloadOpAsin, payloadVal(d), 0)
+	}
+
+	dSig, dExp := d.decompose()
+
+	if dSig[0]|dSig[1] == 0 {
+		return d
+	}
+
+	neg := d.Signbit()
+
+	if int(dExp)-exponentBias+dSig.log10() < -20 {
+		// asin(x) = x + x**3/6 + ..., which is indistinguishable from x at
+		// this precision, but always has a slightly larger magnitude.
+		sig, exp := mode.reduce128(neg, dSig, dExp, 1)
+		return compose(neg, sig, exp)
+	}
+
+	val := decomposed192{
+		sig: uint192{dSig[0], dSig[1], 0},
+		exp: dExp - exponentBias,
+	}
+
+	lt1, omx, _ := val.sub1(0)
+	if !lt1 {
+		if omx.sig[0]|omx.sig[1]|omx.sig[2] != 0 {
+			return nan(payloadOpAsin, payloadVal(d), 0)
+		}
+
+		sig, exp := mode.reduce192(neg, halfPi.sig, halfPi.exp+exponentBias, 1)
+		return compose(neg, sig, exp)
+	}
+
+	// asin(x) = atan(x / sqrt((1 - x) * (1 + x)))
+	opx, _ := val.add1(0)
+
+	res, trunc := omx.mul(opx, 0)
+	res, trunc = res.sqrt()
+	res, trunc = val.quo(res, trunc)
+	res, trunc = res.atan(trunc)
+
+	sig, exp := mode.reduce192(neg, res.sig, res.exp+exponentBias, trunc)
+	return compose(neg, sig, exp)
+}
+
+// Atan returns the arctangent, in radians, of d, rounded using the
+// [DefaultRoundingMode].
+func Atan(d Decimal) Decimal {
+	return AtanWithMode(d, DefaultRoundingMode)
+}
+
+// AtanWithMode returns the arctangent, in radians, of d, rounded using the
+// provided rounding mode.
+func AtanWithMode(d Decimal, mode RoundingMode) Decimal {
+	neg := d.Signbit()
+
+	if d.isSpecial() {
+		if d.IsNaN() {
+			return d.quiet()
+		}
+
+		sig, exp := mode.reduce192(neg, halfPi.sig, halfPi.exp+exponentBias, 1)
+		return compose(neg, sig, exp)
+	}
+
+	dSig, dExp := d.decompose()
+
+	if dSig[0]|dSig[1] == 0 {
+		return d
+	}
+
+	if int(dExp)-exponentBias+dSig.log10() < -20 {
+		// atan(x) = x - x**3/3 + ..., which is indistinguishable from x at
+		// this precision, but always has a slightly smaller magnitude.
+		sig, exp := mode.reduce128(neg, dSig, dExp, -1)
+		return compose(neg, sig, exp)
+	}
+
+	res, trunc := decomposed192{
+		sig: uint192{dSig[0], dSig[1], 0},
+		exp: dExp - exponentBias,
+	}.atan(0)
+
+	sig, exp := mode.reduce192(neg, res.sig, res.exp+exponentBias, trunc)
+	return compose(neg, sig, exp)
+}
+
+// Atan2 returns the arctangent, in radians, of y/x, using the signs of the
+// two values to determine the quadrant of the result, rounded using the
+// [DefaultRoundingMode].
+func Atan2(y, x Decimal) Decimal {
+	return Atan2WithMode(y, x, DefaultRoundingMode)
+}
+
+// Atan2WithMode returns the arctangent, in radians, of y/x, using the signs
+// of the two values to determine the quadrant of the result, rounded using the
+// provided rounding mode.
+//
+// Special cases are handled as defined by IEEE 754:
+//
+//	Atan2(y, NaN) = NaN
+//	Atan2(NaN, x) = NaN
+//	Atan2(±0, x>=0) = ±0
+//	Atan2(±0, x<=-0) = ±π
+//	Atan2(y>0, 0) = +π/2
+//	Atan2(y<0, 0) = -π/2
+//	Atan2(±Inf, +Inf) = ±π/4
+//	Atan2(±Inf, -Inf) = ±3π/4
+//	Atan2(y, +Inf) = ±0
+//	Atan2(y>0, -Inf) = +π
+//	Atan2(y<0, -Inf) = -π
+//	Atan2(±Inf, x) = ±π/2
+func Atan2WithMode(y, x Decimal, mode RoundingMode) Decimal {
+	if y.IsNaN() {
+		return y.quiet()
+	}
+
+	if x.IsNaN() {
+		return x.quiet()
+	}
+
+	neg := y.Signbit()
+
+	var res decomposed192
+	var trunc int8
+
+	switch {
+	case y.IsZero():
+		if !x.Signbit() {
+			return y
+		}
+
+		res, trunc = halfPi.add(halfPi, 1)
+	case x.isInf():
+		if y.isInf() {
+			if x.Signbit() {
+				res, trunc = halfPi.mul(decomposed192{
+					sig: uint192{15, 0, 0},
+					exp: -1,
+				}, 1)
+			} else {
+				res, trunc = halfPi.mul(decomposed192{
+					sig: uint192{5, 0, 0},
+					exp: -1,
+				}, 1)
+			}
+		} else if x.Signbit() {
+			res, trunc = halfPi.add(halfPi, 1)
+		} else {
+			return zero(neg)
+		}
+	case y.isInf() || x.IsZero():
+		res, trunc = halfPi, 1
+	default:
+		ySig, yExp := y.decompose()
+		xSig, xExp := x.decompose()
+
+		res, trunc = decomposed192{
+			sig: uint192{ySig[0], ySig[1], 0},
+			exp: yExp - exponentBias,
+		}.quo(decomposed192{
+			sig: uint192{xSig[0], xSig[1], 0},
+			exp: xExp - exponentBias,
+		}, 0)
+
+		res, trunc = res.atan(trunc)
+
+		if x.Signbit() {
+			pi, _ := halfPi.add(halfPi, 0)
+			_, res, trunc = pi.sub(res, trunc)
+		}
+	}
+
+	sig, exp := mode.reduce192(neg, res.sig, res.exp+exponentBias, trunc)
+	return compose(neg, sig, exp)
+}
+
+// Cos returns the cosine of the radian argument d, rounded using the
+// [DefaultRoundingMode].
+func Cos(d Decimal) Decimal {
+	return CosWithMode(d, DefaultRoundingMode)
+}
+
+// CosWithMode returns the cosine of the radian argument d, rounded using the
+// provided rounding mode.
+func CosWithMode(d Decimal, mode RoundingMode) Decimal {
+	if d.isSpecial() {
+		if d.IsNaN() {
+			return d.quiet()
+		}
+
+		return nan(payloadOpCos, payloadVal(d), 0)
+	}
+
+	dSig, dExp := d.decompose()
+
+	if dSig[0]|dSig[1] == 0 {
+		return one(false)
+	}
+
+	if int(dExp)-exponentBias+dSig.log10() < -20 {
+		// cos(x) = 1 - x**2/2 + ..., which is indistinguishable from 1 at
+		// this precision, but is always slightly less than it.
+		sig, exp := mode.reduce128(false, uint128{1, 0}, exponentBias, -1)
+		return compose(false, sig, exp)
+	}
+
+	q, neg, r, trunc := reduceHalfPi(dSig, dExp)
+
+	var res decomposed192
+	switch q {
+	case 0:
+		res, trunc = r.cos(trunc)
+		neg = false
+	case 1:
+		res, trunc = r.sin(trunc)
+		neg = !neg
+	case 2:
+		res, trunc = r.cos(trunc)
+		neg = true
+	case 3:
+		res, trunc = r.sin(trunc)
+	}
+
+	sig, exp := mode.reduce192(neg, res.sig, res.exp+exponentBias, trunc)
+	return compose(neg, sig, exp)
+}
+
+// Sin returns the sine of the radian argument d, rounded using the
+// [DefaultRoundingMode].
+func Sin(d Decimal) Decimal {
+	return SinWithMode(d, DefaultRoundingMode)
+}
+
+// SinWithMode returns the sine of the radian argument d, rounded using the
+// provided rounding mode.
+func SinWithMode(d Decimal, mode RoundingMode) Decimal {
+	if d.isSpecial() {
+		if d.IsNaN() {
+			return d.quiet()
+		}
+
+		return nan(payloadOpSin, payloadVal(d), 0)
+	}
+
+	dSig, dExp := d.decompose()
+
+	if dSig[0]|dSig[1] == 0 {
+		return d
+	}
+
+	if int(dExp)-exponentBias+dSig.log10() < -20 {
+		// sin(x) = x - x**3/6 + ..., which is indistinguishable from x at
+		// this precision, but always has a slightly smaller magnitude.
+		sig, exp := mode.reduce128(d.Signbit(), dSig, dExp, -1)
+		return compose(d.Signbit(), sig, exp)
+	}
+
+	q, neg, r, trunc := reduceHalfPi(dSig, dExp)
+
+	var res decomposed192
+	switch q {
+	case 0:
+		res, trunc = r.sin(trunc)
+	case 1:
+		res, trunc = r.cos(trunc)
+		neg = false
+	case 2:
+		res, trunc = r.sin(trunc)
+		neg = !neg
+	case 3:
+		res, trunc = r.cos(trunc)
+		neg = true
+	}
+
+	neg = neg != d.Signbit()
+
+	sig, exp := mode.reduce192(neg, res.sig, res.exp+exponentBias, trunc)
+	return compose(neg, sig, exp)
+}
+
+// Sincos returns Sin(d), Cos(d), rounded using the [DefaultRoundingMode].
+func Sincos(d Decimal) (Decimal, Decimal) {
+	return SincosWithMode(d, DefaultRoundingMode)
+}
+
+// SincosWithMode returns Sin(d), Cos(d), rounded using the provided rounding
+// mode. Calculating both values at the same time is faster than calling
+// [SinWithMode] and [CosWithMode] separately.
+func SincosWithMode(d Decimal, mode RoundingMode) (Decimal, Decimal) {
+	if d.isSpecial() {
+		if d.IsNaN() {
+			return d.quiet(), d.quiet()
+		}
+
+		return nan(payloadOpSin, payloadVal(d), 0), nan(payloadOpCos, payloadVal(d), 0)
+	}
+
+	dSig, dExp := d.decompose()
+
+	if dSig[0]|dSig[1] == 0 {
+		return d, one(false)
+	}
+
+	if int(dExp)-exponentBias+dSig.log10() < -20 {
+		ssig, sexp := mode.reduce128(d.Signbit(), dSig, dExp, -1)
+		csig, cexp := mode.reduce128(false, uint128{1, 0}, exponentBias, -1)
+		return compose(d.Signbit(), ssig, sexp), compose(false, csig, cexp)
+	}
+
+	q, neg, r, trunc := reduceHalfPi(dSig, dExp)
+
+	s, strunc := r.sin(trunc)
+	c, ctrunc := r.cos(trunc)
+
+	sneg := neg
+	cneg := false
+
+	switch q {
+	case 1:
+		s, c = c, s
+		strunc, ctrunc = ctrunc, strunc
+		sneg, cneg = false, !neg
+	case 2:
+		sneg, cneg = !neg, true
+	case 3:
+		s, c = c, s
+		strunc, ctrunc = ctrunc, strunc
+		sneg, cneg = true, neg
+	}
+
+	sneg = sneg != d.Signbit()
+
+	ssig, sexp := mode.reduce192(sneg, s.sig, s.exp+exponentBias, strunc)
+	csig, cexp := mode.reduce192(cneg, c.sig, c.exp+exponentBias, ctrunc)
+
+	return compose(sneg, ssig, sexp), compose(cneg, csig, cexp)
+}
+
+// Tan returns the tangent of the radian argument d, rounded using the
+// [DefaultRoundingMode].
+func Tan(d Decimal) Decimal {
+	return TanWithMode(d, DefaultRoundingMode)
+}
+
+// TanWithMode returns the tangent of the radian argument d, rounded using the
+// provided rounding mode.
+func TanWithMode(d Decimal, mode RoundingMode) Decimal {
+	if d.isSpecial() {
+		if d.IsNaN() {
+			return d.quiet()
+		}
+
+		return nan(payloadOpTan, payloadVal(d), 0)
+	}
+
+	dSig, dExp := d.decompose()
+
+	if dSig[0]|dSig[1] == 0 {
+		return d
+	}
+
+	if int(dExp)-exponentBias+dSig.log10() < -20 {
+		// tan(x) = x + x**3/3 + ..., which is indistinguishable from x at
+		// this precision, but always has a slightly larger magnitude.
+		sig, exp := mode.reduce128(d.Signbit(), dSig, dExp, 1)
+		return compose(d.Signbit(), sig, exp)
+	}
+
+	q, neg, r, trunc := reduceHalfPi(dSig, dExp)
+
+	s, strunc := r.sin(trunc)
+	c, ctrunc := r.cos(trunc)
+
+	if q%2 == 1 {
+		s, c = c, s
+		strunc, ctrunc = ctrunc, strunc
+		neg = !neg
+	}
+
+	// An inexact numerator moves the quotient in the same direction, and an
+	// inexact denominator moves it in the opposite direction.
+	trunc = strunc
+	if trunc == 0 {
+		trunc = -ctrunc
+	}
+
+	res, trunc := s.quo(c, trunc)
+
+	neg = neg != d.Signbit()
+
+	sig, exp := mode.reduce192(neg, res.sig, res.exp+exponentBias, trunc)
+	return compose(neg, sig, exp)
+}
+
+// reduceHalfPi reduces the magnitude of the value sig*10**(exp-exponentBias)
+// modulo π/2, returning the quadrant the value lies in along with the
+// remainder, which has a magnitude of at most π/4. Values less than 1 are
+// returned unchanged in the first quadrant.
+//
+// The reduction multiplies the value by the digits of 2/π, of which only a
+// window of 190 digits around the position of the decimal point is needed:
+// earlier digits only contribute multiples of 4 to the product, and later
+// digits are too small to affect the result.
+func reduceHalfPi(sig uint128, exp int16) (uint64, bool, decomposed192, int8) {
+	e := int(exp) - exponentBias
+
+	if e+sig.log10() < 0 {
+		return 0, false, decomposed192{
+			sig: uint192{sig[0], sig[1], 0},
+			exp: int16(e),
+		}, 0
+	}
+
+	// Scale the value so that its exponent is a multiple of 19, which aligns
+	// it with the base 10**19 digits of 2/π, then split it into base 10**19
+	// digits.
+	shift := (e%19 + 19) % 19
+	e -= shift
+
+	sig192 := uint192{sig[0], sig[1], 0}.mul64(uint128PowersOf10[shift][0])
+
+	var lhs [3]uint64
+	for i := range lhs {
+		sig192, lhs[i] = sig192.div1e19()
+	}
+
+	// The first digit of the window contains the digits of 2/π that will be
+	// multiplied into the units of the integer part, while the remaining
+	// digits will form the fractional part.
+	start := e/19 - 1
+
+	var rhs [10]uint64
+	for i := range rhs {
+		if idx := start + len(rhs) - 1 - i; idx >= 0 && idx < len(twoOverPi) {
+			rhs[i] = twoOverPi[idx]
+		}
+	}
+
+	var prd [13]uint64
+	for i, l := range lhs {
+		var carry uint64
+		for j, r := range rhs {
+			hi, lo := bits.Mul64(l, r)
+
+			var c uint64
+			lo, c = bits.Add64(lo, prd[i+j], 0)
+			hi += c
+			lo, c = bits.Add64(lo, carry, 0)
+			hi += c
+
+			carry, prd[i+j] = bits.Div64(hi, lo, 10_000_000_000_000_000_000)
+		}
+
+		prd[i+len(rhs)] = carry
+	}
+
+	// The integer part of the product is the quadrant, and since 10**19 is a
+	// multiple of 4 only its lowest digit is needed. If the fractional part
+	// is at least one half, the value is closer to the next quadrant and the
+	// remainder becomes negative.
+	frac := prd[:len(rhs)-1]
+	q := prd[len(rhs)-1]
+	neg := false
+
+	if frac[len(frac)-1] >= 5_000_000_000_000_000_000 {
+		var brw uint64
+		for i, f := range frac {
+			if f|brw == 0 {
+				continue
+			}
+
+			frac[i] = 10_000_000_000_000_000_000 - f - brw
+			brw = 1
+		}
+
+		q++
+		neg = true
+	}
+
+	top := len(frac) - 1
+	for top >= 0 && frac[top] == 0 {
+		top--
+	}
+
+	var res decomposed192
+	res.exp = int16(19 * (top - len(frac) - 2))
+
+	for i := top; i > top-3; i-- {
+		res.sig = res.sig.mul64(10_000_000_000_000_000_000)
+
+		if i >= 0 {
+			res.sig = res.sig.add64(frac[i])
+		}
+	}
+
+	res, trunc := res.mul(halfPi, 1)
+	return q % 4, neg, res, trunc
+}
@@ -0,0 +1,212 @@
+package decimal128
+
+import "testing"
+
+func TestAcos(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var res Decimal
+
+	for r.scan("acos(%v) = %v\n", &val, &res) {
+		acos := Acos(val)
+
+		if !resultEqual(acos, res) {
+			t.Errorf("Acos(%v) = %v, want %v", val, acos, res)
+		}
+
+		lo := AcosWithMode(val, ToNegativeInf)
+		hi := AcosWithMode(val, ToPositiveInf)
+
+		if !res.IsNaN() && (lo.Cmp(res).Greater() || hi.Cmp(res).Less()) {
+			t.Errorf("AcosWithMode(%v, ToNegativeInf/ToPositiveInf) = [%v, %v], want interval containing %v", val, lo, hi, res)
+		}
+	}
+}
+
+func TestAsin(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var res Decimal
+
+	for r.scan("asin(%v) = %v\n", &val, &res) {
+		asin := Asin(val)
+
+		if !resultEqual(asin, res) {
+			t.Errorf("Asin(%v) = %v, want %v", val, asin, res)
+		}
+
+		lo := AsinWithMode(val, ToNegativeInf)
+		hi := AsinWithMode(val, ToPositiveInf)
+
+		if !res.IsNaN() && (lo.Cmp(res).Greater() || hi.Cmp(res).Less()) {
+			t.Errorf("AsinWithMode(%v, ToNegativeInf/ToPositiveInf) = [%v, %v], want interval containing %v", val, lo, hi, res)
+		}
+	}
+}
+
+func TestAtan(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var res Decimal
+
+	for r.scan("atan(%v) = %v\n", &val, &res) {
+		atan := Atan(val)
+
+		if !resultEqual(atan, res) {
+			t.Errorf("Atan(%v) = %v, want %v", val, atan, res)
+		}
+
+		lo := AtanWithMode(val, ToNegativeInf)
+		hi := AtanWithMode(val, ToPositiveInf)
+
+		if !res.IsNaN() && (lo.Cmp(res).Greater() || hi.Cmp(res).Less()) {
+			t.Errorf("AtanWithMode(%v, ToNegativeInf/ToPositiveInf) = [%v, %v], want interval containing %v", val, lo, hi, res)
+		}
+	}
+}
+
+func TestAtan2(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var y Decimal
+	var x Decimal
+	var res Decimal
+
+	for r.scan("atan2(%v, %v) = %v\n", &y, &x, &res) {
+		atan2 := Atan2(y, x)
+
+		if !resultEqual(atan2, res) {
+			t.Errorf("Atan2(%v, %v) = %v, want %v", y, x, atan2, res)
+		}
+
+		lo := Atan2WithMode(y, x, ToNegativeInf)
+		hi := Atan2WithMode(y, x, ToPositiveInf)
+
+		if !res.IsNaN() && (lo.Cmp(res).Greater() || hi.Cmp(res).Less()) {
+			t.Errorf("Atan2WithMode(%v, %v, ToNegativeInf/ToPositiveInf) = [%v, %v], want interval containing %v", y, x, lo, hi, res)
+		}
+	}
+}
+
+func TestCos(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var res Decimal
+
+	for r.scan("cos(%v) = %v\n", &val, &res) {
+		cos := Cos(val)
+
+		if !resultEqual(cos, res) {
+			t.Errorf("Cos(%v) = %v, want %v", val, cos, res)
+		}
+
+		lo := CosWithMode(val, ToNegativeInf)
+		hi := CosWithMode(val, ToPositiveInf)
+
+		if !res.IsNaN() && (lo.Cmp(res).Greater() || hi.Cmp(res).Less()) {
+			t.Errorf("CosWithMode(%v, ToNegativeInf/ToPositiveInf) = [%v, %v], want interval containing %v", val, lo, hi, res)
+		}
+	}
+}
+
+func TestSin(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var res Decimal
+
+	for r.scan("sin(%v) = %v\n", &val, &res) {
+		sin := Sin(val)
+
+		if !resultEqual(sin, res) {
+			t.Errorf("Sin(%v) = %v, want %v", val, sin, res)
+		}
+
+		lo := SinWithMode(val, ToNegativeInf)
+		hi := SinWithMode(val, ToPositiveInf)
+
+		if !res.IsNaN() && (lo.Cmp(res).Greater() || hi.Cmp(res).Less()) {
+			t.Errorf("SinWithMode(%v, ToNegativeInf/ToPositiveInf) = [%v, %v], want interval containing %v", val, lo, hi, res)
+		}
+	}
+}
+
+func TestSincos(t *testing.T) {
+	t.Parallel()
+
+	values := []Decimal{
+		zero(false),
+		zero(true),
+		New(1, -25),
+		New(5, -1),
+		FromInt64(1),
+		FromInt64(-2),
+		FromInt64(3),
+		FromInt64(-4),
+		FromInt64(5),
+		New(1, 22),
+		New(-1, 100),
+		New(1, 6111),
+		inf(false),
+		NaN(),
+	}
+
+	for _, val := range values {
+		for _, mode := range roundingModes {
+			sin, cos := SincosWithMode(val, mode)
+			wsin := SinWithMode(val, mode)
+			wcos := CosWithMode(val, mode)
+
+			if !resultEqual(sin, wsin) || !resultEqual(cos, wcos) {
+				t.Errorf("SincosWithMode(%v, %v) = (%v, %v), want (%v, %v)", val, mode, sin, cos, wsin, wcos)
+			}
+		}
+	}
+}
+
+func TestTan(t *testing.T) {
+	t.Parallel()
+
+	r := openTestData(t)
+	defer r.close()
+
+	var val Decimal
+	var res Decimal
+
+	for r.scan("tan(%v) = %v\n", &val, &res) {
+		tan := Tan(val)
+
+		if !resultEqual(tan, res) {
+			t.Errorf("Tan(%v) = %v, want %v", val, tan, res)
+		}
+
+		lo := TanWithMode(val, ToNegativeInf)
+		hi := TanWithMode(val, ToPositiveInf)
+
+		if !res.IsNaN() && (lo.Cmp(res).Greater() || hi.Cmp(res).Less()) {
+			t.Errorf("TanWithMode(%v, ToNegativeInf/ToPositiveInf) = [%v, %v], want interval containing %v", val, lo, hi, res)
+		}
+	}
+}